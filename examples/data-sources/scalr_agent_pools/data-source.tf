@@ -0,0 +1,11 @@
+data "scalr_agent_pools" "production" {
+  name = "like:production-"
+}
+
+data "scalr_agent_pools" "environment" {
+  environment_id = "env-xxxxxxxxxx"
+}
+
+data "scalr_agent_pools" "all" {
+  account_id = "acc-xxxxxxxxxx"
+}
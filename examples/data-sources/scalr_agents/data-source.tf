@@ -0,0 +1,10 @@
+data "scalr_agents" "pool" {
+  agent_pool_id = "apool-xxxxxxxxxx"
+}
+
+check "agent_pool_health" {
+  assert {
+    condition     = data.scalr_agents.pool.healthy_count > 0
+    error_message = "Agent pool has no healthy agents."
+  }
+}
@@ -0,0 +1,11 @@
+data "scalr_drift_detection_results" "production" {
+  environment_id = "env-xxxxxxxxxx"
+  drifted_only   = true
+}
+
+check "no_drift" {
+  assert {
+    condition     = length(data.scalr_drift_detection_results.production.drifted_workspace_ids) == 0
+    error_message = "Drifted workspaces: ${join(", ", data.scalr_drift_detection_results.production.workspaces[*].workspace_name)}"
+  }
+}
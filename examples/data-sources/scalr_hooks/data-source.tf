@@ -0,0 +1,11 @@
+data "scalr_hooks" "production" {
+  name = "like:production-"
+}
+
+data "scalr_hooks" "environment" {
+  environment_id = "env-xxxxxxxxxx"
+}
+
+data "scalr_hooks" "all" {
+  account_id = "acc-xxxxxxxxxx"
+}
@@ -0,0 +1,8 @@
+data "scalr_iam_teams" "devops" {
+  name = "devops"
+}
+
+data "scalr_iam_teams" "idp" {
+  identity_provider_id = "idp-xxxxxxxxxx"
+  account_id           = "acc-xxxxxxxxxx"
+}
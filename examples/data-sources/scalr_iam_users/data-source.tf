@@ -0,0 +1,12 @@
+data "scalr_iam_users" "active" {
+  status = "Active"
+}
+
+data "scalr_iam_users" "example-com" {
+  email = "@example.com"
+}
+
+data "scalr_iam_users" "idp" {
+  identity_provider_id = "idp-xxxxxxxxxx"
+  account_id           = "acc-xxxxxxxxxx"
+}
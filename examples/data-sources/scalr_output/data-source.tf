@@ -0,0 +1,8 @@
+data "scalr_output" "vpc_id" {
+  workspace_id = "ws-xxxxxxxxxx"
+  name         = "vpc_id"
+}
+
+output "vpc_id" {
+  value = data.scalr_output.vpc_id.nonsensitive_value
+}
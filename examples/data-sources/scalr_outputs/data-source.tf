@@ -0,0 +1,10 @@
+data "scalr_outputs" "by_name" {
+  environment = "production"
+  workspace   = "network"
+}
+
+data "scalr_outputs" "selected" {
+  workspace_id    = "ws-xxxxxxxxxx"
+  names           = ["vpc_id", "subnet_ids"]
+  fail_on_missing = true
+}
@@ -0,0 +1,13 @@
+data "scalr_permissions" "all" {}
+
+data "scalr_permissions" "workspaces" {
+  object_type = "workspaces"
+}
+
+resource "scalr_role" "workspace-reader" {
+  name = "workspace-reader"
+  permissions = [
+    for p in data.scalr_permissions.workspaces.permissions : p.id
+    if !p.deprecated && endswith(p.id, ":read")
+  ]
+}
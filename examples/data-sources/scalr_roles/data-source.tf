@@ -0,0 +1,9 @@
+data "scalr_roles" "system" {
+  is_system = true
+}
+
+data "scalr_roles" "custom" {
+  name       = "deploy"
+  is_system  = false
+  account_id = "acc-xxxxxxxxxx"
+}
@@ -0,0 +1,11 @@
+data "scalr_run" "example" {
+  id = "run-xxxxxxxxxx"
+}
+
+output "resources_to_destroy" {
+  value = data.scalr_run.example.plan[0].resources_to_destroy
+}
+
+output "hard_failed_policies" {
+  value = sum(data.scalr_run.example.policy_checks[*].hard_failed)
+}
@@ -0,0 +1,10 @@
+data "scalr_runs" "failed" {
+  workspace_id  = "ws-xxxxxxxxxx"
+  statuses      = ["errored"]
+  created_after = "2024-01-01T00:00:00Z"
+}
+
+data "scalr_runs" "drift" {
+  environment_id = "env-xxxxxxxxxx"
+  sources        = ["drift"]
+}
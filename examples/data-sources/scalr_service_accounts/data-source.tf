@@ -0,0 +1,8 @@
+data "scalr_service_accounts" "active" {
+  status = "Active"
+}
+
+data "scalr_service_accounts" "ci" {
+  name       = "ci-"
+  account_id = "acc-xxxxxxxxxx"
+}
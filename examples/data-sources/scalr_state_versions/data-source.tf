@@ -0,0 +1,8 @@
+data "scalr_state_versions" "example" {
+  workspace_id = "ws-xxxxxxxxxx"
+  max_results  = 1
+}
+
+output "latest_serial" {
+  value = data.scalr_state_versions.example.state_versions[0].serial
+}
@@ -0,0 +1,13 @@
+data "scalr_storage_profiles" "all" {
+  account_id = "acc-xxxxxxxxxx"
+}
+
+data "scalr_storage_profiles" "aws" {
+  backend_type = "aws-s3"
+}
+
+output "environments_by_storage_profile" {
+  value = {
+    for sp in data.scalr_storage_profiles.all.storage_profiles : sp.name => sp.environment_ids
+  }
+}
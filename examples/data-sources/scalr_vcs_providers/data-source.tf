@@ -0,0 +1,11 @@
+data "scalr_vcs_providers" "production" {
+  name = "like:production-"
+}
+
+data "scalr_vcs_providers" "environment" {
+  environment_id = "env-xxxxxxxxxx"
+}
+
+data "scalr_vcs_providers" "all" {
+  account_id = "acc-xxxxxxxxxx"
+}
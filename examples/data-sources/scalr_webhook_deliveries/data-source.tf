@@ -0,0 +1,5 @@
+data "scalr_webhook_deliveries" "example" {
+  webhook_id  = "wh-xxxxxxxxxx"
+  status      = "failed"
+  max_results = 10
+}
@@ -0,0 +1,11 @@
+data "scalr_webhooks" "production" {
+  name = "like:production-"
+}
+
+data "scalr_webhooks" "environment" {
+  environment_id = "env-xxxxxxxxxx"
+}
+
+data "scalr_webhooks" "all" {
+  account_id = "acc-xxxxxxxxxx"
+}
@@ -0,0 +1,10 @@
+resource "scalr_workspace" "cli" {
+  name           = "cli-driven"
+  environment_id = "env-xxxxxxxxxx"
+}
+
+resource "scalr_configuration_version" "cli" {
+  workspace_id    = scalr_workspace.cli.id
+  directory       = "${path.module}/infrastructure"
+  auto_queue_runs = true
+}
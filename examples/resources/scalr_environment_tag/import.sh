@@ -0,0 +1 @@
+terraform import scalr_environment_tag.example env-xxxxxxxxxx/tag-xxxxxxxxxx
@@ -0,0 +1,8 @@
+resource "scalr_tag" "cost_center" {
+  name = "cost-center:platform"
+}
+
+resource "scalr_environment_tag" "example" {
+  environment_id = "env-xxxxxxxxxx"
+  tag_id         = scalr_tag.cost_center.id
+}
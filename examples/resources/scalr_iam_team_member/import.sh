@@ -0,0 +1 @@
+terraform import scalr_iam_team_member.example team-xxxxxxxxxx/user-xxxxxxxxxx
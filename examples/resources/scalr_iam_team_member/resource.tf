@@ -0,0 +1,8 @@
+data "scalr_iam_user" "engineer" {
+  email = "engineer@example.com"
+}
+
+resource "scalr_iam_team_member" "example" {
+  team_id = "team-xxxxxxxxxx"
+  user_id = data.scalr_iam_user.engineer.id
+}
@@ -0,0 +1,5 @@
+# Import the user of the current account
+terraform import scalr_iam_user.example user-xxxxxxxxxx
+
+# Import the user of the specified account
+terraform import scalr_iam_user.example acc-xxxxxxxxxx/user-xxxxxxxxxx
@@ -0,0 +1,9 @@
+resource "scalr_iam_user" "example" {
+  email      = "engineer@example.com"
+  account_id = "acc-xxxxxxxxxx"
+}
+
+resource "scalr_iam_team_member" "example" {
+  team_id = "team-xxxxxxxxxx"
+  user_id = scalr_iam_user.example.id
+}
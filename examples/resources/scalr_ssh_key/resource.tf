@@ -8,4 +8,14 @@ EOF
 
   account_id   = "acc-xxxxxxxxxx"
   environments = ["env-xxxxxxxxxx"]
-}
\ No newline at end of file
+}
+
+# Using write-only private key (Terraform 1.11+)
+resource "scalr_ssh_key" "example2" {
+  name                   = "example-ssh-key-wo"
+  private_key_wo         = ephemeral.aws_secretsmanager_secret.deploy_key.secret_string
+  private_key_wo_version = 1 # Increment to rotate the key in place when the secret changes
+
+  account_id   = "acc-xxxxxxxxxx"
+  environments = ["env-xxxxxxxxxx"]
+}
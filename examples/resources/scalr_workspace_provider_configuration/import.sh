@@ -0,0 +1,5 @@
+# Import by the link ID
+terraform import scalr_workspace_provider_configuration.example pcfgl-xxxxxxxxxx
+
+# Import by the workspace and provider configuration IDs, with an optional alias
+terraform import scalr_workspace_provider_configuration.aliased ws-xxxxxxxxxx/pcfg-yyyyyyyyyy/secondary
@@ -0,0 +1,10 @@
+resource "scalr_workspace_provider_configuration" "example" {
+  workspace_id              = "ws-xxxxxxxxxx"
+  provider_configuration_id = "pcfg-xxxxxxxxxx"
+}
+
+resource "scalr_workspace_provider_configuration" "aliased" {
+  workspace_id              = "ws-xxxxxxxxxx"
+  provider_configuration_id = "pcfg-yyyyyyyyyy"
+  alias                     = "secondary"
+}
@@ -0,0 +1,9 @@
+data "scalr_workspace" "network" {
+  name           = "network"
+  environment_id = "env-xxxxxxxxxx"
+}
+
+resource "scalr_workspace_remote_state_consumer" "example" {
+  workspace_id          = data.scalr_workspace.network.id
+  consumer_workspace_id = "ws-xxxxxxxxxx"
+}
@@ -0,0 +1 @@
+terraform import scalr_workspace_ssh_key.example ws-xxxxxxxxxx
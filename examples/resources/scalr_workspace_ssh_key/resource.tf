@@ -0,0 +1,4 @@
+resource "scalr_workspace_ssh_key" "example" {
+  workspace_id = "ws-xxxxxxxxxx"
+  ssh_key_id   = "ssh-xxxxxxxxxx"
+}
@@ -0,0 +1,9 @@
+resource "scalr_workspace" "migrated" {
+  name           = "migrated"
+  environment_id = "env-xxxxxxxxxx"
+}
+
+resource "scalr_workspace_state" "migrated" {
+  workspace_id = scalr_workspace.migrated.id
+  state        = file("${path.module}/terraform.tfstate")
+}
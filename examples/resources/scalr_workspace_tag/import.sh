@@ -0,0 +1 @@
+terraform import scalr_workspace_tag.example ws-xxxxxxxxxx/tag-xxxxxxxxxx
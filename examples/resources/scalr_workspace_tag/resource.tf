@@ -0,0 +1,8 @@
+resource "scalr_tag" "cost_center" {
+  name = "cost-center:platform"
+}
+
+resource "scalr_workspace_tag" "example" {
+  workspace_id = "ws-xxxxxxxxxx"
+  tag_id       = scalr_tag.cost_center.id
+}
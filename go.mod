@@ -17,6 +17,7 @@ require (
 	github.com/hashicorp/terraform-svchost v0.1.1
 	github.com/scalr/go-scalr v0.0.0-20260626143013-d94b397dd89e
 	github.com/scalr/go-scalr/v2 v2.0.0-rc.4
+	golang.org/x/crypto v0.54.0
 )
 
 require (
@@ -75,7 +76,6 @@ require (
 	github.com/yuin/goldmark-meta v1.1.0 // indirect
 	github.com/zclconf/go-cty v1.17.0 // indirect
 	go.abhg.dev/goldmark/frontmatter v0.2.0 // indirect
-	golang.org/x/crypto v0.54.0 // indirect
 	golang.org/x/exp v0.0.0-20230626212559-97b1e661b5df // indirect
 	golang.org/x/mod v0.37.0 // indirect
 	golang.org/x/net v0.56.0 // indirect
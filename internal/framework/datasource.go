@@ -11,8 +11,9 @@ import (
 )
 
 type DataSourceWithScalrClient struct {
-	Client   *scalr.Client
-	ClientV2 *scalrV2.Client
+	Client      *scalr.Client
+	ClientV2    *scalrV2.Client
+	LookupCache *LookupCache
 }
 
 func (d *DataSourceWithScalrClient) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -35,4 +36,5 @@ func (d *DataSourceWithScalrClient) Configure(_ context.Context, req datasource.
 
 	d.Client = c.Client
 	d.ClientV2 = c.ClientV2
+	d.LookupCache = c.LookupCache
 }
@@ -0,0 +1,135 @@
+package framework
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/scalr/go-scalr"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+)
+
+// permissionInMessageRe matches the permission name, as in "workspaces:update".
+var permissionInMessageRe = regexp.MustCompile(`\b([a-z][a-z-]*:[a-z][a-z-]*)\b`)
+
+// APIErrorPaths maps the JSON:API source pointers of a resource, such as `/data/attributes/name`
+// or `/data/relationships/environment`, to the Terraform attribute paths.
+type APIErrorPaths map[string]path.Path
+
+// APIErrorOption customizes the translation of API errors into diagnostics.
+type APIErrorOption func(*apiErrorConfig)
+
+type apiErrorConfig struct {
+	paths      APIErrorPaths
+	permission string
+}
+
+// WithAttributePaths sets the table used to attach the errors to the Terraform attributes.
+// A pointer to a nested field, such as `/data/attributes/vcs-repo/branch`, is matched
+// by its closest listed parent. Errors with a pointer missing from the table are not attached to any attribute.
+func WithAttributePaths(paths APIErrorPaths) APIErrorOption {
+	return func(c *apiErrorConfig) {
+		c.paths = paths
+	}
+}
+
+// WithRequiredPermission sets the permission to name in the hint for 403 Forbidden responses.
+func WithRequiredPermission(permission string) APIErrorOption {
+	return func(c *apiErrorConfig) {
+		c.permission = permission
+	}
+}
+
+// APIErrorDiagnostics translates an error returned by the Scalr API client into diagnostics.
+//
+// For the v2 client, the JSON:API error is attached to the Terraform attribute its source
+// points to, if the pointer is listed with WithAttributePaths, and a remediation hint is added
+// for the common client errors. The v1 client flattens the API errors into a plain message
+// without the status code or the source, so its errors are reported as is, with a hint
+// only for the not found responses.
+//
+// Errors not coming from the API are reported as is.
+func APIErrorDiagnostics(summary string, err error, opts ...APIErrorOption) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err == nil {
+		return diags
+	}
+
+	cfg := &apiErrorConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	hint := apiErrorHint(err, cfg)
+
+	var apiErr *client.JSONAPIError
+	if !errors.As(err, &apiErr) {
+		diags.AddError(summary, appendHint(err.Error(), hint))
+		return diags
+	}
+
+	detail := apiErr.Title
+	if apiErr.Detail != "" {
+		detail = apiErr.Detail
+	}
+
+	if apiErr.Source != nil && apiErr.Source.Pointer != "" {
+		if p, ok := cfg.attributePath(apiErr.Source.Pointer); ok {
+			diags.AddAttributeError(p, summary, appendHint(detail, hint))
+			return diags
+		}
+		detail = fmt.Sprintf("%s (%s)", detail, apiErr.Source.Pointer)
+	}
+	diags.AddError(summary, appendHint(detail, hint))
+
+	return diags
+}
+
+// attributePath looks up the Terraform attribute path of the JSON:API source pointer,
+// falling back to the parent pointers.
+func (c *apiErrorConfig) attributePath(pointer string) (path.Path, bool) {
+	for p := pointer; strings.Count(p, "/") >= 3; p = p[:strings.LastIndex(p, "/")] {
+		if attrPath, ok := c.paths[p]; ok {
+			return attrPath, true
+		}
+	}
+	return path.Empty(), false
+}
+
+func apiErrorHint(err error, cfg *apiErrorConfig) string {
+	switch {
+	case errors.Is(err, client.ErrForbidden):
+		permission := cfg.permission
+		if permission == "" {
+			if m := permissionInMessageRe.FindStringSubmatch(err.Error()); m != nil {
+				permission = m[1]
+			}
+		}
+		if permission != "" {
+			return fmt.Sprintf("The token used by the provider is not allowed to perform this operation."+
+				" Make sure its role grants the `%s` permission on the target scope.", permission)
+		}
+		return "The token used by the provider is not allowed to perform this operation." +
+			" Make sure its role grants the required permission on the target scope."
+	case errors.Is(err, client.ErrNotFound), errors.Is(err, scalr.ErrResourceNotFound):
+		return "The object does not exist or the token used by the provider has no access to it." +
+			" Check the referenced IDs, and whether the object was removed outside of Terraform."
+	case errors.Is(err, client.ErrConflict):
+		return "The request conflicts with the current state of the object." +
+			" Check if an object with the same name already exists, or if the object is in use."
+	case errors.Is(err, client.ErrUnprocessableEntity):
+		return "The API rejected the attribute values. Check the configuration against the documented constraints."
+	default:
+		return ""
+	}
+}
+
+func appendHint(detail, hint string) string {
+	if hint == "" {
+		return detail
+	}
+	return detail + "\n\n" + hint
+}
@@ -0,0 +1,168 @@
+package framework
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/scalr/go-scalr"
+	scalrV2 "github.com/scalr/go-scalr/v2/scalr"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+)
+
+// apiError returns the error the v2 client reports for the API response.
+func apiError(t *testing.T, status int, body string) error {
+	t.Helper()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := scalrV2.NewClient(
+		strings.TrimPrefix(srv.URL, "https://"), "token",
+		client.WithHTTPClient(srv.Client()),
+		client.WithRetryMax(0),
+	)
+	_, err := c.Workspace.GetWorkspace(context.Background(), "ws-123", nil)
+	if err == nil {
+		t.Fatal("expected an API error")
+	}
+	return err
+}
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	workspacePaths := APIErrorPaths{
+		"/data/attributes/execution-mode": path.Root("execution_mode"),
+		"/data/attributes/vcs-repo":       path.Root("vcs_repo"),
+		"/data/relationships/agent-pool":  path.Root("agent_pool_id"),
+		"/data/relationships/tags":        path.Root("tag_ids"),
+	}
+
+	tests := map[string]struct {
+		status     int
+		body       string
+		err        error
+		opts       []APIErrorOption
+		wantPath   path.Path
+		wantDetail []string
+	}{
+		"attribute pointer": {
+			status:     http.StatusUnprocessableEntity,
+			body:       `{"errors":[{"status":"422","title":"Invalid Attribute","detail":"Value must be one of: local, remote.","source":{"pointer":"/data/attributes/execution-mode"}}]}`,
+			opts:       []APIErrorOption{WithAttributePaths(workspacePaths)},
+			wantPath:   path.Root("execution_mode"),
+			wantDetail: []string{"Value must be one of: local, remote.", "Check the configuration"},
+		},
+		"nested attribute pointer": {
+			status:     http.StatusUnprocessableEntity,
+			body:       `{"errors":[{"status":"422","title":"Invalid Attribute","detail":"Branch not found.","source":{"pointer":"/data/attributes/vcs-repo/branch"}}]}`,
+			opts:       []APIErrorOption{WithAttributePaths(workspacePaths)},
+			wantPath:   path.Root("vcs_repo"),
+			wantDetail: []string{"Branch not found."},
+		},
+		"to-one relationship pointer": {
+			status:   http.StatusUnprocessableEntity,
+			body:     `{"errors":[{"status":"422","title":"Invalid Relationship","source":{"pointer":"/data/relationships/agent-pool"}}]}`,
+			opts:     []APIErrorOption{WithAttributePaths(workspacePaths)},
+			wantPath: path.Root("agent_pool_id"),
+		},
+		"to-many relationship pointer": {
+			status:   http.StatusUnprocessableEntity,
+			body:     `{"errors":[{"status":"422","title":"Invalid Relationship","detail":"Tag not found.","source":{"pointer":"/data/relationships/tags"}}]}`,
+			opts:     []APIErrorOption{WithAttributePaths(workspacePaths)},
+			wantPath: path.Root("tag_ids"),
+		},
+		"unmapped pointer": {
+			status:     http.StatusUnprocessableEntity,
+			body:       `{"errors":[{"status":"422","title":"Invalid Relationship","detail":"Policy group not found.","source":{"pointer":"/data/relationships/policy-groups"}}]}`,
+			opts:       []APIErrorOption{WithAttributePaths(workspacePaths)},
+			wantPath:   path.Empty(),
+			wantDetail: []string{"Policy group not found. (/data/relationships/policy-groups)"},
+		},
+		"pointer without table": {
+			status:     http.StatusUnprocessableEntity,
+			body:       `{"errors":[{"status":"422","title":"Invalid Attribute","detail":"Name is too long.","source":{"pointer":"/data/attributes/name"}}]}`,
+			wantPath:   path.Empty(),
+			wantDetail: []string{"Name is too long. (/data/attributes/name)"},
+		},
+		"forbidden with permission": {
+			status:     http.StatusForbidden,
+			body:       `{"errors":[{"status":"403","title":"Forbidden"}]}`,
+			opts:       []APIErrorOption{WithRequiredPermission("workspaces:create")},
+			wantPath:   path.Empty(),
+			wantDetail: []string{"`workspaces:create`"},
+		},
+		"forbidden with permission in message": {
+			status:     http.StatusForbidden,
+			body:       `{"errors":[{"status":"403","title":"Forbidden","detail":"Permission environments:update is required."}]}`,
+			wantPath:   path.Empty(),
+			wantDetail: []string{"`environments:update`"},
+		},
+		"forbidden without JSON:API body": {
+			status:     http.StatusForbidden,
+			body:       `Access denied`,
+			wantPath:   path.Empty(),
+			wantDetail: []string{"forbidden: Access denied", "the required permission"},
+		},
+		"conflict": {
+			status:     http.StatusConflict,
+			body:       `{"errors":[{"status":"409","title":"Conflict","detail":"Name is already taken."}]}`,
+			wantPath:   path.Empty(),
+			wantDetail: []string{"Name is already taken.", "already exists"},
+		},
+		"not found": {
+			status:     http.StatusNotFound,
+			body:       `{"errors":[{"status":"404","title":"Not Found","detail":"Workspace with ID 'ws-123' not found."}]}`,
+			wantPath:   path.Empty(),
+			wantDetail: []string{"not found", "Check the referenced IDs"},
+		},
+		"v1 not found": {
+			err:        scalr.ErrResourceNotFound,
+			wantPath:   path.Empty(),
+			wantDetail: []string{"Check the referenced IDs"},
+		},
+		"plain error": {
+			err:        errors.New("something went wrong"),
+			wantPath:   path.Empty(),
+			wantDetail: []string{"something went wrong"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.err
+			if err == nil {
+				err = apiError(t, tt.status, tt.body)
+			}
+
+			diags := APIErrorDiagnostics("Error", err, tt.opts...)
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %d: %v", len(diags), diags)
+			}
+			d := diags[0]
+			if d.Severity() != diag.SeverityError {
+				t.Errorf("expected error severity, got %s", d.Severity())
+			}
+			got := path.Empty()
+			if dp, ok := d.(diag.DiagnosticWithPath); ok {
+				got = dp.Path()
+			}
+			if !got.Equal(tt.wantPath) {
+				t.Errorf("expected path %q, got %q", tt.wantPath, got)
+			}
+			for _, want := range tt.wantDetail {
+				if !strings.Contains(d.Detail(), want) {
+					t.Errorf("expected detail to contain %q, got %q", want, d.Detail())
+				}
+			}
+		})
+	}
+}
@@ -10,8 +10,9 @@ import (
 )
 
 type Clients struct {
-	Client   *scalr.Client
-	ClientV2 *scalrV2.Client
+	Client      *scalr.Client
+	ClientV2    *scalrV2.Client
+	LookupCache *LookupCache
 }
 
 type AttrGetter interface {
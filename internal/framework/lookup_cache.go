@@ -0,0 +1,170 @@
+package framework
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultLookupCacheTTL is how long a successful lookup result is reused.
+// Terraform reads the same data sources repeatedly within a single plan or apply,
+// so even a short TTL removes most of the duplicate list calls.
+const DefaultLookupCacheTTL = 2 * time.Minute
+
+// Object types the lookup cache entries are grouped by.
+// A mutation of an object invalidates all cached lookups of the same type.
+const (
+	LookupEnvironments        = "environments"
+	LookupWorkspaces          = "workspaces"
+	LookupVcsProviders        = "vcs-providers"
+	LookupRoles               = "roles"
+	LookupPermissions         = "permissions"
+	LookupWebhookIntegrations = "webhook-integrations"
+)
+
+// LookupCache caches the results of name-based lookups for a single provider instance.
+// It is created once per provider server, shared by both halves of the muxed provider
+// and handed to the resources and data sources along with the API clients, see Clients.
+// Concurrent lookups with the same key are coalesced into a single API request,
+// successful results are kept for the configured TTL, and errors are never cached.
+//
+// A nil *LookupCache is valid and performs every lookup directly.
+type LookupCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*lookupEntry
+}
+
+type lookupEntry struct {
+	objectType string
+	done       chan struct{}
+	val        interface{}
+	err        error
+	expires    time.Time
+}
+
+// NewLookupCache returns an empty cache that keeps entries for the given duration.
+func NewLookupCache(ttl time.Duration) *LookupCache {
+	return &LookupCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]*lookupEntry),
+	}
+}
+
+// Do returns the cached result for the given object type and key, or calls fn to fetch it.
+// The key should describe the endpoint and the complete filter, so different queries never collide.
+//
+// The shared call runs with the context of the caller that started it. If it fails because
+// that context was canceled or timed out, the waiters whose own context is still live retry
+// the lookup instead of returning the error of the other caller.
+func (c *LookupCache) Do(ctx context.Context, objectType, key string, fn func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return fn()
+	}
+
+	k := objectType + "\x00" + key
+
+	for {
+		c.mu.Lock()
+		e, ok := c.entries[k]
+		if ok {
+			select {
+			case <-e.done:
+				if e.err == nil && c.now().Before(e.expires) {
+					c.mu.Unlock()
+					return e.val, nil
+				}
+				// Expired or failed, fetch it again below.
+				ok = false
+			default:
+			}
+		}
+		if ok {
+			// The same lookup is in flight, wait for its result.
+			c.mu.Unlock()
+			select {
+			case <-e.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			if isContextError(e.err) && ctx.Err() == nil {
+				continue
+			}
+			return e.val, e.err
+		}
+
+		e = &lookupEntry{objectType: objectType, done: make(chan struct{})}
+		c.entries[k] = e
+		c.mu.Unlock()
+
+		c.run(k, e, fn)
+		return e.val, e.err
+	}
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// run calls fn and stores its result in the entry. The waiters are released even if fn panics,
+// they get an error then, and the panic is propagated to the caller.
+func (c *LookupCache) run(k string, e *lookupEntry, fn func() (interface{}, error)) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			e.val, e.err = nil, fmt.Errorf("lookup of %s panicked: %v", e.objectType, r)
+		}
+
+		c.mu.Lock()
+		e.expires = c.now().Add(c.ttl)
+		if e.err != nil && c.entries[k] == e {
+			delete(c.entries, k)
+		}
+		c.mu.Unlock()
+		close(e.done)
+
+		if r != nil {
+			panic(r)
+		}
+	}()
+
+	e.val, e.err = fn()
+}
+
+// Invalidate drops all cached lookups of the given object types.
+// Lookups that are in flight still deliver their result to the current waiters,
+// but it is not reused by the subsequent calls.
+func (c *LookupCache) Invalidate(objectTypes ...string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, e := range c.entries {
+		for _, t := range objectTypes {
+			if e.objectType == t {
+				delete(c.entries, k)
+				break
+			}
+		}
+	}
+}
+
+// CachedLookup is a typed wrapper around LookupCache.Do.
+func CachedLookup[T any](ctx context.Context, c *LookupCache, objectType, key string, fn func() (T, error)) (T, error) {
+	v, err := c.Do(ctx, objectType, key, func() (interface{}, error) {
+		return fn()
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return v.(T), nil
+}
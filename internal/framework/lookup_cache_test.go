@@ -0,0 +1,224 @@
+package framework
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLookupCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reuses result within ttl", func(t *testing.T) {
+		c := NewLookupCache(time.Minute)
+		var calls int
+		fetch := func() (string, error) {
+			calls++
+			return "env-123", nil
+		}
+
+		for i := 0; i < 3; i++ {
+			v, err := CachedLookup(ctx, c, LookupEnvironments, "name=test", fetch)
+			if err != nil || v != "env-123" {
+				t.Fatalf("unexpected result: %q, %v", v, err)
+			}
+		}
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("expires after ttl", func(t *testing.T) {
+		c := NewLookupCache(time.Minute)
+		now := time.Now()
+		c.now = func() time.Time { return now }
+		var calls int
+		fetch := func() (string, error) {
+			calls++
+			return "env-123", nil
+		}
+
+		_, _ = CachedLookup(ctx, c, LookupEnvironments, "name=test", fetch)
+		now = now.Add(2 * time.Minute)
+		_, _ = CachedLookup(ctx, c, LookupEnvironments, "name=test", fetch)
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		c := NewLookupCache(time.Minute)
+		var calls int
+		fetch := func() (string, error) {
+			calls++
+			return "", errors.New("boom")
+		}
+
+		_, _ = CachedLookup(ctx, c, LookupEnvironments, "name=test", fetch)
+		_, err := CachedLookup(ctx, c, LookupEnvironments, "name=test", fetch)
+		if err == nil {
+			t.Error("expected error")
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("invalidates by object type", func(t *testing.T) {
+		c := NewLookupCache(time.Minute)
+		var envCalls, wsCalls int
+		fetchEnv := func() (string, error) {
+			envCalls++
+			return "env-123", nil
+		}
+		fetchWs := func() (string, error) {
+			wsCalls++
+			return "ws-123", nil
+		}
+
+		_, _ = CachedLookup(ctx, c, LookupEnvironments, "name=test", fetchEnv)
+		_, _ = CachedLookup(ctx, c, LookupWorkspaces, "name=test", fetchWs)
+		c.Invalidate(LookupEnvironments)
+		_, _ = CachedLookup(ctx, c, LookupEnvironments, "name=test", fetchEnv)
+		_, _ = CachedLookup(ctx, c, LookupWorkspaces, "name=test", fetchWs)
+
+		if envCalls != 2 {
+			t.Errorf("expected 2 environment calls, got %d", envCalls)
+		}
+		if wsCalls != 1 {
+			t.Errorf("expected 1 workspace call, got %d", wsCalls)
+		}
+	})
+
+	t.Run("coalesces concurrent lookups", func(t *testing.T) {
+		c := NewLookupCache(time.Minute)
+		var calls int32
+		release := make(chan struct{})
+		fetch := func() (string, error) {
+			atomic.AddInt32(&calls, 1)
+			<-release
+			return "env-123", nil
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				v, err := CachedLookup(ctx, c, LookupEnvironments, "name=test", fetch)
+				if err != nil || v != "env-123" {
+					t.Errorf("unexpected result: %q, %v", v, err)
+				}
+			}()
+		}
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		if n := atomic.LoadInt32(&calls); n != 1 {
+			t.Errorf("expected 1 call, got %d", n)
+		}
+	})
+
+	t.Run("releases waiters when lookup panics", func(t *testing.T) {
+		c := NewLookupCache(time.Minute)
+		started := make(chan struct{})
+		release := make(chan struct{})
+
+		go func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected the panic to be propagated")
+				}
+			}()
+			_, _ = CachedLookup(ctx, c, LookupEnvironments, "name=test", func() (string, error) {
+				close(started)
+				<-release
+				panic("boom")
+			})
+		}()
+
+		<-started
+		waitErr := make(chan error, 1)
+		go func() {
+			_, err := CachedLookup(ctx, c, LookupEnvironments, "name=test", func() (string, error) {
+				return "env-123", nil
+			})
+			waitErr <- err
+		}()
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+
+		select {
+		case err := <-waitErr:
+			if err == nil {
+				t.Error("expected the waiter to get an error")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("waiter is still blocked")
+		}
+
+		v, err := CachedLookup(ctx, c, LookupEnvironments, "name=test", func() (string, error) {
+			return "env-123", nil
+		})
+		if err != nil || v != "env-123" {
+			t.Errorf("expected the lookup to be retried, got: %q, %v", v, err)
+		}
+	})
+
+	t.Run("retries for waiters when the first caller is canceled", func(t *testing.T) {
+		c := NewLookupCache(time.Minute)
+		started := make(chan struct{})
+		firstCtx, cancel := context.WithCancel(ctx)
+
+		go func() {
+			_, _ = CachedLookup(firstCtx, c, LookupEnvironments, "name=test", func() (string, error) {
+				close(started)
+				<-firstCtx.Done()
+				return "", firstCtx.Err()
+			})
+		}()
+
+		<-started
+		waitResult := make(chan string, 1)
+		go func() {
+			v, err := CachedLookup(ctx, c, LookupEnvironments, "name=test", func() (string, error) {
+				return "env-123", nil
+			})
+			if err != nil {
+				t.Errorf("expected the waiter to retry the lookup, got: %v", err)
+			}
+			waitResult <- v
+		}()
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+
+		select {
+		case v := <-waitResult:
+			if v != "env-123" {
+				t.Errorf("unexpected result: %q", v)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("waiter is still blocked")
+		}
+	})
+
+	t.Run("nil cache calls through", func(t *testing.T) {
+		var c *LookupCache
+		var calls int
+		fetch := func() (string, error) {
+			calls++
+			return "env-123", nil
+		}
+
+		_, _ = CachedLookup(ctx, c, LookupEnvironments, "name=test", fetch)
+		_, _ = CachedLookup(ctx, c, LookupEnvironments, "name=test", fetch)
+		c.Invalidate(LookupEnvironments)
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+}
@@ -12,8 +12,9 @@ import (
 )
 
 type ResourceWithScalrClient struct {
-	Client   *scalr.Client
-	ClientV2 *scalrV2.Client
+	Client      *scalr.Client
+	ClientV2    *scalrV2.Client
+	LookupCache *LookupCache
 }
 
 func (r *ResourceWithScalrClient) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -33,4 +34,5 @@ func (r *ResourceWithScalrClient) Configure(_ context.Context, req resource.Conf
 
 	r.Client = c.Client
 	r.ClientV2 = c.ClientV2
+	r.LookupCache = c.LookupCache
 }
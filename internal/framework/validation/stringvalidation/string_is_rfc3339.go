@@ -0,0 +1,43 @@
+package stringvalidation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Compile-time interface check
+var _ validator.String = stringIsRFC3339Validator{}
+
+type stringIsRFC3339Validator struct{}
+
+func (v stringIsRFC3339Validator) Description(_ context.Context) string {
+	return "must be a valid RFC3339 timestamp, e.g. 2024-01-02T15:04:05Z"
+}
+
+func (v stringIsRFC3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringIsRFC3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%q", value),
+		))
+	}
+}
+
+func StringIsRFC3339() validator.String {
+	return stringIsRFC3339Validator{}
+}
@@ -94,7 +94,7 @@ func (r *agentPoolTokenResource) Create(ctx context.Context, req resource.Create
 
 	agentPoolToken, err := r.Client.AgentPoolTokens.Create(ctx, plan.AgentPoolID.ValueString(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating agent_pool_token", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error creating agent_pool_token", err)...)
 		return
 	}
 
@@ -170,7 +170,7 @@ func (r *agentPoolTokenResource) Update(ctx context.Context, req resource.Update
 	// Update existing resource
 	agentPoolToken, err := r.Client.AccessTokens.Update(ctx, plan.Id.ValueString(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating agent_pool_token", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error updating agent_pool_token", err)...)
 		return
 	}
 
@@ -195,7 +195,7 @@ func (r *agentPoolTokenResource) Delete(ctx context.Context, req resource.Delete
 
 	err := r.Client.AccessTokens.Delete(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, scalr.ErrResourceNotFound) {
-		resp.Diagnostics.AddError("Error deleting agent_pool_token", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting agent_pool_token", err)...)
 		return
 	}
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccScalrAgentPoolToken_basic(t *testing.T) {
@@ -106,7 +108,7 @@ func TestAccScalrAgentPoolToken_UpgradeFromSDK(t *testing.T) {
 
 func testAccCheckScalrAgentPoolTokenExists(resId string, pool scalr.AgentPool, token *scalr.AccessToken) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		rs, ok := s.RootModule().Resources[resId]
 		if !ok {
@@ -167,7 +169,7 @@ func createPool(t *testing.T) scalr.AgentPool {
 }
 
 func testAccCheckScalrAgentPoolTokenDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_agent_pool_token" {
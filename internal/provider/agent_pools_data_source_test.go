@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrAgentPoolsDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrAgentPoolsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_agent_pools.test", "id"),
+					resource.TestCheckResourceAttr("data.scalr_agent_pools.test", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.scalr_agent_pools.env", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_agent_pools.env", "ids.0",
+						"scalr_agent_pool.shared", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccScalrAgentPoolsDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource scalr_environment test {
+  name       = "test-env-pools-%d"
+  account_id = "%s"
+}
+
+resource scalr_agent_pool shared {
+  name         = "agent-pools-%[1]d-shared"
+  environments = [scalr_environment.test.id]
+}
+
+resource scalr_agent_pool private {
+  name = "agent-pools-%[1]d-private"
+}
+
+data scalr_agent_pools test {
+  name       = "like:agent-pools-%[1]d-"
+  account_id = "%[2]s"
+  depends_on = [scalr_agent_pool.shared, scalr_agent_pool.private]
+}
+
+data scalr_agent_pools env {
+  name           = "like:agent-pools-%[1]d-"
+  environment_id = scalr_environment.test.id
+  account_id     = "%[2]s"
+  depends_on     = [scalr_agent_pool.shared, scalr_agent_pool.private]
+}`, rInt, defaultAccount)
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/ops/agent"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource              = &agentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &agentsDataSource{}
+)
+
+func newAgentsDataSource() datasource.DataSource {
+	return &agentsDataSource{}
+}
+
+// agentsDataSource defines the data source implementation.
+type agentsDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// agentsDataSourceModel describes the data source data model.
+type agentsDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	AgentPoolID  types.String `tfsdk:"agent_pool_id"`
+	Status       types.String `tfsdk:"status"`
+	IDs          types.Set    `tfsdk:"ids"`
+	Agents       types.List   `tfsdk:"agents"`
+	HealthyCount types.Int64  `tfsdk:"healthy_count"`
+}
+
+// agentModel describes a single agent in the list.
+type agentModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Status        types.String `tfsdk:"status"`
+	ErrorMessage  types.String `tfsdk:"error_message"`
+	Version       types.String `tfsdk:"version"`
+	UpgradeStatus types.String `tfsdk:"upgrade_status"`
+	Os            types.String `tfsdk:"os"`
+	CpuPlatform   types.String `tfsdk:"cpu_platform"`
+	Driver        types.String `tfsdk:"driver"`
+	LastSeenAt    types.String `tfsdk:"last_seen_at"`
+}
+
+var agentElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":             types.StringType,
+		"name":           types.StringType,
+		"status":         types.StringType,
+		"error_message":  types.StringType,
+		"version":        types.StringType,
+		"upgrade_status": types.StringType,
+		"os":             types.StringType,
+		"cpu_platform":   types.StringType,
+		"driver":         types.StringType,
+		"last_seen_at":   types.StringType,
+	},
+}
+
+func (d *agentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agents"
+}
+
+func (d *agentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the agents connected to an agent pool and their status.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of this data source.",
+				Computed:            true,
+			},
+			"agent_pool_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the agent pool, in the format `apool-<RANDOM STRING>`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return agents in this status. Valid values are `idle`, `busy`, `offline` and `errored`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(schemas.AgentStatusIdle),
+						string(schemas.AgentStatusBusy),
+						string(schemas.AgentStatusOffline),
+						string(schemas.AgentStatusErrored),
+					),
+				},
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "The list of agent IDs, in the format [`agent-xxxxxxxxxxx`, `agent-yyyyyyyyy`].",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"agents": schema.ListAttribute{
+				MarkdownDescription: "The list of agents, sorted by name. Each agent has the `id`, `name`, `status`," +
+					" the `error_message` of an errored agent, the agent `version` and its `upgrade_status`," +
+					" the `os`, `cpu_platform` and `driver` it runs on, and the `last_seen_at` time in RFC3339 format.",
+				ElementType: agentElementType,
+				Computed:    true,
+			},
+			"healthy_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of returned agents that are ready to take runs, i.e. in the `idle` or `busy` status.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *agentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg agentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolID := cfg.AgentPoolID.ValueString()
+	opts := &agent.GetAgentsOptions{
+		Filter:   map[string]string{"agent-pool": poolID},
+		PageSize: 100,
+	}
+	if !cfg.Status.IsNull() {
+		opts.Filter["status"] = cfg.Status.ValueString()
+	}
+
+	agents := make([]agentModel, 0)
+	ids := make([]string, 0)
+	var healthy int64
+	for a, err := range d.ClientV2.Agent.GetAgentsIter(ctx, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving agents", err.Error())
+			return
+		}
+
+		if a.Relationships.Pool != nil && a.Relationships.Pool.ID != poolID {
+			continue
+		}
+		if !cfg.Status.IsNull() && string(a.Attributes.Status) != cfg.Status.ValueString() {
+			continue
+		}
+
+		if a.Attributes.Status == schemas.AgentStatusIdle || a.Attributes.Status == schemas.AgentStatusBusy {
+			healthy++
+		}
+
+		lastSeenAt := types.StringNull()
+		if !a.Attributes.LastSeenAt.IsZero() {
+			lastSeenAt = types.StringValue(a.Attributes.LastSeenAt.Format(time.RFC3339))
+		}
+
+		agents = append(agents, agentModel{
+			Id:            types.StringValue(a.ID),
+			Name:          types.StringValue(a.Attributes.Name),
+			Status:        types.StringValue(string(a.Attributes.Status)),
+			ErrorMessage:  types.StringPointerValue(a.Attributes.ErrorMessage),
+			Version:       types.StringValue(a.Attributes.Version),
+			UpgradeStatus: types.StringValue(string(a.Attributes.UpgradeStatus)),
+			Os:            types.StringValue(a.Attributes.Os),
+			CpuPlatform:   types.StringValue(string(a.Attributes.CpuPlatform)),
+			Driver:        types.StringValue(string(a.Attributes.Driver)),
+			LastSeenAt:    lastSeenAt,
+		})
+		ids = append(ids, a.ID)
+	}
+
+	sort.Slice(agents, func(i, j int) bool {
+		return agents[i].Name.ValueString() < agents[j].Name.ValueString()
+	})
+
+	cfg.Id = types.StringValue(fmt.Sprintf("%d", framework.HashString(poolID+cfg.Status.ValueString())))
+	cfg.HealthyCount = types.Int64Value(healthy)
+
+	idsValue, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	cfg.IDs = idsValue
+
+	agentsValue, diags := types.ListValueFrom(ctx, agentElementType, agents)
+	resp.Diagnostics.Append(diags...)
+	cfg.Agents = agentsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
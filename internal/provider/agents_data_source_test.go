@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrAgentsDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      `data scalr_agents test {}`,
+				ExpectError: regexp.MustCompile(`The argument "agent_pool_id" is required`),
+				PlanOnly:    true,
+			},
+			{
+				Config: `
+data scalr_agents test {
+  agent_pool_id = "apool-123"
+  status        = "running"
+}`,
+				ExpectError: regexp.MustCompile("Attribute status value must be one of"),
+				PlanOnly:    true,
+			},
+			{
+				Config: fmt.Sprintf(`
+resource scalr_agent_pool test {
+  name = "agents-%d"
+}
+
+data scalr_agents test {
+  agent_pool_id = scalr_agent_pool.test.id
+}
+
+data scalr_agents idle {
+  agent_pool_id = scalr_agent_pool.test.id
+  status        = "idle"
+}`, rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_agents.test", "id"),
+					resource.TestCheckResourceAttr("data.scalr_agents.test", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.scalr_agents.test", "agents.#", "0"),
+					resource.TestCheckResourceAttr("data.scalr_agents.test", "healthy_count", "0"),
+					resource.TestCheckResourceAttr("data.scalr_agents.idle", "healthy_count", "0"),
+				),
+			},
+		},
+	})
+}
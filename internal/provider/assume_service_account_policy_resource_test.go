@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccScalrAssumeServiceAccountPolicy_basic(t *testing.T) {
@@ -168,7 +170,7 @@ resource "scalr_assume_service_account_policy" "test" {
 
 func testAccCheckScalrAssumeServiceAccountPolicyExists(resId string, policy *scalr.AssumeServiceAccountPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		rs, ok := s.RootModule().Resources[resId]
 		if !ok {
@@ -191,7 +193,7 @@ func testAccCheckScalrAssumeServiceAccountPolicyExists(resId string, policy *sca
 }
 
 func testAccCheckScalrAssumeServiceAccountPolicyDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_assume_service_account_policy" {
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// terraformIgnoreFile is the file with the exclusion rules for the configuration upload.
+const terraformIgnoreFile = ".terraformignore"
+
+// defaultTerraformIgnore is applied before the rules from .terraformignore,
+// matching the behaviour of the Terraform CLI.
+const defaultTerraformIgnore = `
+.git/
+.terraform/
+!.terraform/modules/
+`
+
+// ignoreRule is a single pattern of a .terraformignore file.
+type ignoreRule struct {
+	re       *regexp.Regexp
+	negated  bool
+	dirOnly  bool
+	anchored bool
+	// prefix is the literal part of the pattern before the first wildcard.
+	prefix string
+}
+
+// parseTerraformIgnore parses the rules of a .terraformignore file, which use the .gitignore syntax.
+func parseTerraformIgnore(r io.Reader) ([]ignoreRule, error) {
+	var rules []ignoreRule
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule := ignoreRule{}
+		if strings.HasPrefix(line, "!") {
+			rule.negated = true
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			rule.dirOnly = true
+			line = strings.TrimRight(line, "/")
+		}
+		// A pattern with a slash other than a trailing one is relative to the root directory,
+		// otherwise it matches at any depth.
+		rule.anchored = strings.Contains(line, "/")
+		line = strings.TrimPrefix(line, "/")
+		if line == "" {
+			continue
+		}
+		rule.prefix = line
+		if i := strings.IndexAny(line, "*?"); i >= 0 {
+			rule.prefix = line[:i]
+		}
+
+		re, err := regexp.Compile(ignorePatternToRegexp(line, rule.anchored))
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", scanner.Text(), err)
+		}
+		rule.re = re
+		rules = append(rules, rule)
+	}
+
+	return rules, scanner.Err()
+}
+
+// ignorePatternToRegexp converts a .gitignore glob to an anchored regular expression.
+func ignorePatternToRegexp(pattern string, anchored bool) string {
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					// `**/` matches zero or more directories.
+					i++
+					b.WriteString("(?:.*/)?")
+				} else {
+					b.WriteString(".*")
+				}
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+// isIgnored reports whether the file or directory at the slash-separated relative path is excluded.
+// A rule matching any parent directory applies to the path as well, and the last matching rule wins,
+// so that `!.terraform/modules/` re-includes the modules excluded by `.terraform/`.
+func isIgnored(rules []ignoreRule, rel string, isDir bool) bool {
+	ignored := false
+	for _, rule := range rules {
+		if ignoreRuleMatches(rule, rel, isDir) {
+			ignored = !rule.negated
+		}
+	}
+	return ignored
+}
+
+// mayReinclude reports whether a negation rule may re-include a path inside the directory,
+// in which case an ignored directory still has to be walked.
+// An unanchored negation can match at any depth, so it is assumed to re-include anything.
+func mayReinclude(rules []ignoreRule, dir string) bool {
+	for _, rule := range rules {
+		if !rule.negated {
+			continue
+		}
+		if !rule.anchored || strings.HasPrefix(rule.prefix, dir+"/") || strings.HasPrefix(dir+"/", rule.prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func ignoreRuleMatches(rule ignoreRule, rel string, isDir bool) bool {
+	if (!rule.dirOnly || isDir) && rule.re.MatchString(rel) {
+		return true
+	}
+	for dir := path.Dir(rel); dir != "."; dir = path.Dir(dir) {
+		if rule.re.MatchString(dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// configurationFiles lists the files and symlinks of the directory to upload, honouring .terraformignore.
+// The paths are slash-separated, relative to the directory, in lexical order.
+func configurationFiles(dir string) ([]string, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	rules, err := parseTerraformIgnore(strings.NewReader(defaultTerraformIgnore))
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(dir, terraformIgnoreFile))
+	if err == nil {
+		fileRules, parseErr := parseTerraformIgnore(f)
+		_ = f.Close()
+		if parseErr != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", terraformIgnoreFile, parseErr)
+		}
+		rules = append(rules, fileRules...)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	var files []string
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			// Skip excluded directories, such as .git, unless a negation rule may re-include their contents.
+			if isIgnored(rules, rel, true) && !mayReinclude(rules, rel) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if isIgnored(rules, rel, false) {
+			return nil
+		}
+		if d.Type().IsRegular() || d.Type()&fs.ModeSymlink != 0 {
+			files = append(files, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// configurationHash returns the SHA256 hash of the paths and contents of the files.
+func configurationHash(dir string, files []string) (string, error) {
+	h := sha256.New()
+	for _, rel := range files {
+		p := filepath.Join(dir, filepath.FromSlash(rel))
+		info, err := os.Lstat(p)
+		if err != nil {
+			return "", err
+		}
+
+		_, _ = fmt.Fprintf(h, "%s\x00%o\x00", rel, info.Mode().Perm())
+		if info.Mode()&fs.ModeSymlink != 0 {
+			target, err := os.Readlink(p)
+			if err != nil {
+				return "", err
+			}
+			_, _ = io.WriteString(h, target)
+		} else if err = copyFileTo(h, p); err != nil {
+			return "", err
+		}
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// packConfiguration writes the files as a gzipped tarball.
+func packConfiguration(dir string, files []string, w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	for _, rel := range files {
+		p := filepath.Join(dir, filepath.FromSlash(rel))
+		info, err := os.Lstat(p)
+		if err != nil {
+			return err
+		}
+
+		link := ""
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if link, err = os.Readlink(p); err != nil {
+				return err
+			}
+		}
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = rel
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			if err = copyFileTo(tw, p); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func copyFileTo(w io.Writer, p string) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestConfigurationFiles(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"main.tf":                               `resource "terraform_data" "test" {}`,
+		"terraform.tfvars":                      `secret = "value"`,
+		"modules/vpc/main.tf":                   `variable "cidr" {}`,
+		"modules/vpc/README.md":                 "VPC module",
+		"docs/index.md":                         "Documentation",
+		".git/HEAD":                             "ref: refs/heads/main",
+		".terraform/providers/registry.txt":     "provider",
+		".terraform/modules/modules.json":       "{}",
+		".terraform/modules/remote/main.tf":     "",
+		"build/cache/output.bin":                "binary",
+		"keep/build/cache/data.txt":             "nested build cache",
+		".terraformignore":                      "# local files\n*.tfvars\n/docs/\n**/README.md\nbuild/\n!keep/build/\n",
+		"examples/complete/terraform.tfvars.j2": "template",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := configurationFiles(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		".terraform/modules/modules.json",
+		".terraform/modules/remote/main.tf",
+		".terraformignore",
+		"examples/complete/terraform.tfvars.j2",
+		"keep/build/cache/data.txt",
+		"main.tf",
+		"modules/vpc/main.tf",
+	}
+	if !slices.Equal(files, want) {
+		t.Errorf("unexpected files:\n got: %v\nwant: %v", files, want)
+	}
+
+	hash, err := configurationHash(dir, files)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, "docs", "index.md"), []byte("Changed"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if rehash, _ := hashConfigurationDirectory(dir); rehash != hash {
+		t.Error("expected the hash to ignore changes of excluded files")
+	}
+	if err = os.WriteFile(filepath.Join(dir, "main.tf"), []byte("# changed"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if rehash, _ := hashConfigurationDirectory(dir); rehash == hash {
+		t.Error("expected the hash to change with the uploaded files")
+	}
+
+	var archive bytes.Buffer
+	if err = packConfiguration(dir, files, &archive); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	gz, err := gzip.NewReader(&archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+	var packed []string
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			break
+		}
+		packed = append(packed, hdr.Name)
+	}
+	if !slices.Equal(packed, want) {
+		t.Errorf("unexpected archive entries:\n got: %v\nwant: %v", packed, want)
+	}
+}
+
+func TestMayReinclude(t *testing.T) {
+	rules, err := parseTerraformIgnore(strings.NewReader(defaultTerraformIgnore + "build/\n!keep/build/\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for dir, want := range map[string]bool{
+		".git":                 false,
+		".terraform":           true,
+		".terraform/providers": false,
+		".terraform/modules":   true,
+		"build":                false,
+		"keep":                 true,
+		"keep/build":           true,
+	} {
+		if got := mayReinclude(rules, dir); got != want {
+			t.Errorf("mayReinclude(%q) = %v, want %v", dir, got, want)
+		}
+	}
+
+	rules, err = parseTerraformIgnore(strings.NewReader(".git/\n!*.tf\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mayReinclude(rules, ".git") {
+		t.Error("expected an unanchored negation to re-include any directory")
+	}
+}
+
+func TestConfigurationFiles_notDirectory(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "main.tf")
+	if err := os.WriteFile(p, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := configurationFiles(p); err == nil {
+		t.Error("expected an error for a file path")
+	}
+	if _, err := configurationFiles(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected an error for a missing directory")
+	}
+}
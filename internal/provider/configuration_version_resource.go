@@ -0,0 +1,432 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+	"github.com/scalr/go-scalr/v2/scalr/value"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+const (
+	configurationVersionUploadTimeout      = 10 * time.Minute
+	configurationVersionUploadPollInterval = 2 * time.Second
+)
+
+// configurationUploadClient sends the files to the upload URL, so that a stalled upload doesn't hang the apply.
+var configurationUploadClient = &http.Client{Timeout: configurationVersionUploadTimeout}
+
+// Compile-time interface checks
+var (
+	_ resource.Resource               = &configurationVersionResource{}
+	_ resource.ResourceWithConfigure  = &configurationVersionResource{}
+	_ resource.ResourceWithModifyPlan = &configurationVersionResource{}
+)
+
+func newConfigurationVersionResource() resource.Resource {
+	return &configurationVersionResource{}
+}
+
+// configurationVersionResource defines the resource implementation.
+type configurationVersionResource struct {
+	framework.ResourceWithScalrClient
+}
+
+// configurationVersionResourceModel describes the resource data model.
+type configurationVersionResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	WorkspaceID   types.String `tfsdk:"workspace_id"`
+	Directory     types.String `tfsdk:"directory"`
+	AutoQueueRuns types.Bool   `tfsdk:"auto_queue_runs"`
+	IsDry         types.Bool   `tfsdk:"is_dry"`
+	SourceHash    types.String `tfsdk:"source_hash"`
+	Status        types.String `tfsdk:"status"`
+}
+
+// configurationVersionAPIErrorPaths maps the API error sources of the configuration version to its attributes.
+var configurationVersionAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/auto-queue-runs": path.Root("auto_queue_runs"),
+	"/data/attributes/is-dry":          path.Root("is_dry"),
+	"/data/relationships/workspace":    path.Root("workspace_id"),
+}
+
+func (r *configurationVersionResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_configuration_version"
+}
+
+func (r *configurationVersionResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads the Terraform configuration from a local directory to a CLI-driven workspace," +
+			" i.e. a workspace without a VCS repository. Files excluded by the `.terraformignore` file in the" +
+			" directory are not uploaded, as well as the `.git` and `.terraform` directories, except for" +
+			" `.terraform/modules`. A change of the uploaded files creates a new configuration version." +
+			"\n\n~> **Note:** Configuration versions cannot be deleted. Destroying this resource only removes it" +
+			" from the Terraform state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the configuration version, in the format `cv-<RANDOM STRING>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace to upload the configuration to, in the format `ws-<RANDOM STRING>`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"directory": schema.StringAttribute{
+				MarkdownDescription: "Path to the local directory with the configuration files," +
+					" e.g. `\"${path.module}/config\"`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"auto_queue_runs": schema.BoolAttribute{
+				MarkdownDescription: "Queue a run as soon as the configuration is uploaded. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_dry": schema.BoolAttribute{
+				MarkdownDescription: "The configuration can only be used for dry runs, which cannot be applied." +
+					" Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_hash": schema.StringAttribute{
+				MarkdownDescription: "The SHA256 hash of the uploaded files.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the configuration version.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *configurationVersionResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		// Nothing to hash on destroy.
+		return
+	}
+
+	var plan configurationVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Directory.IsUnknown() {
+		return
+	}
+
+	hash, err := hashConfigurationDirectory(plan.Directory.ValueString())
+	if errors.Is(err, fs.ErrNotExist) {
+		// The directory may be created during apply, e.g. by a local_file or an archive resource,
+		// so the hash is left unknown and computed on create. An existing upload cannot be compared, so it is replaced.
+		plan.SourceHash = types.StringUnknown()
+		if !req.State.Raw.IsNull() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source_hash"))
+		}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("directory"), "Error reading configuration directory", err.Error())
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state configurationVersionResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.SourceHash.ValueString() != hash {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source_hash"))
+		} else {
+			plan.Status = state.Status
+		}
+	}
+
+	plan.SourceHash = types.StringValue(hash)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *configurationVersionResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan configurationVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dir := plan.Directory.ValueString()
+	files, err := configurationFiles(dir)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("directory"), "Error reading configuration directory", err.Error())
+		return
+	}
+	hash, err := configurationHash(dir, files)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("directory"), "Error reading configuration directory", err.Error())
+		return
+	}
+	if !plan.SourceHash.IsUnknown() && plan.SourceHash.ValueString() != hash {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("directory"),
+			"Configuration changed during apply",
+			fmt.Sprintf("The files in %s have changed since the plan. Run the plan again.", dir),
+		)
+		return
+	}
+
+	var archive bytes.Buffer
+	if err = packConfiguration(dir, files, &archive); err != nil {
+		resp.Diagnostics.AddError("Error packing configuration", err.Error())
+		return
+	}
+
+	opts := &schemas.ConfigurationVersionRequest{
+		Attributes: schemas.ConfigurationVersionAttributesRequest{
+			AutoQueueRuns: value.Set(plan.AutoQueueRuns.ValueBool()),
+			IsDry:         value.Set(plan.IsDry.ValueBool()),
+		},
+		Relationships: schemas.ConfigurationVersionRelationshipsRequest{
+			Workspace: value.Set(schemas.Workspace{ID: plan.WorkspaceID.ValueString()}),
+		},
+	}
+	cvID, uploadURL, err := r.createConfigurationVersion(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error creating configuration version", err,
+			framework.WithAttributePaths(configurationVersionAPIErrorPaths),
+			framework.WithRequiredPermission("workspaces:update"),
+		)...)
+		return
+	}
+
+	tflog.Debug(ctx, "Uploading configuration", map[string]interface{}{
+		"configuration_version_id": cvID,
+		"files":                    len(files),
+		"size":                     archive.Len(),
+	})
+	if err = uploadConfiguration(ctx, uploadURL, &archive); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error uploading configuration version %s", cvID), err.Error())
+		return
+	}
+
+	cv, err := r.waitForConfigurationVersionUpload(ctx, cvID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error waiting for configuration version %s upload", cvID), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(cv.ID)
+	plan.SourceHash = types.StringValue(hash)
+	plan.Status = types.StringValue(string(cv.Attributes.Status))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *configurationVersionResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state configurationVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cv, err := r.ClientV2.ConfigurationVersion.GetConfigurationVersion(ctx, state.Id.ValueString(), nil)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Debug(ctx, "Configuration version not found, removing from state", map[string]interface{}{
+				"configuration_version_id": state.Id.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading configuration version %s", state.Id.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	state.AutoQueueRuns = types.BoolValue(cv.Attributes.AutoQueueRuns)
+	state.IsDry = types.BoolValue(cv.Attributes.IsDry)
+	state.Status = types.StringValue(string(cv.Attributes.Status))
+	if cv.Relationships.Workspace != nil {
+		state.WorkspaceID = types.StringValue(cv.Relationships.Workspace.ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *configurationVersionResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	// Only the directory path can change without replacement, when the files are the same.
+	var plan configurationVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *configurationVersionResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+	// Configuration versions cannot be deleted, the resource is only removed from the Terraform state.
+}
+
+// createConfigurationVersion creates the configuration version and returns its ID and the URL to upload the files to.
+// The upload URL is only returned as a JSON:API link, so the raw response is decoded.
+func (r *configurationVersionResource) createConfigurationVersion(
+	ctx context.Context,
+	opts *schemas.ConfigurationVersionRequest,
+) (string, string, error) {
+	raw, err := r.ClientV2.ConfigurationVersion.CreateConfigurationVersionRaw(ctx, opts)
+	if err != nil {
+		return "", "", err
+	}
+	defer raw.Body.Close()
+
+	var result struct {
+		Data struct {
+			ID    string `json:"id"`
+			Links struct {
+				Upload string `json:"upload"`
+			} `json:"links"`
+		} `json:"data"`
+	}
+	if err = json.NewDecoder(raw.Body).Decode(&result); err != nil {
+		return "", "", fmt.Errorf("failed to decode response: %w", err)
+	}
+	if result.Data.Links.Upload == "" {
+		return "", "", fmt.Errorf("configuration version %s has no upload link", result.Data.ID)
+	}
+
+	return result.Data.ID, result.Data.Links.Upload, nil
+}
+
+// waitForConfigurationVersionUpload waits until the uploaded files are processed.
+func (r *configurationVersionResource) waitForConfigurationVersionUpload(
+	ctx context.Context,
+	id string,
+) (*schemas.ConfigurationVersion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{string(schemas.ConfigurationVersionStatusPending)},
+		Target:  []string{string(schemas.ConfigurationVersionStatusUploaded)},
+		Refresh: func() (interface{}, string, error) {
+			cv, err := r.ClientV2.ConfigurationVersion.GetConfigurationVersion(ctx, id, nil)
+			if err != nil {
+				return nil, "", err
+			}
+			if cv.Attributes.Status == schemas.ConfigurationVersionStatusErrored {
+				msg := "unknown error"
+				if cv.Attributes.ErrorMessage != nil {
+					msg = *cv.Attributes.ErrorMessage
+				}
+				return nil, "", fmt.Errorf("configuration version errored: %s", msg)
+			}
+			return cv, string(cv.Attributes.Status), nil
+		},
+		Timeout:    configurationVersionUploadTimeout,
+		MinTimeout: configurationVersionUploadPollInterval,
+	}
+
+	cv, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cv.(*schemas.ConfigurationVersion), nil
+}
+
+// uploadConfiguration uploads the gzipped tarball to the pre-signed upload URL.
+// The URL carries its own authorization, so neither the API token nor the request logging is used.
+func uploadConfiguration(ctx context.Context, uploadURL string, archive *bytes.Buffer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, archive)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := configurationUploadClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("upload failed with status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+	return nil
+}
+
+// hashConfigurationDirectory returns the hash of the files to upload from the directory.
+func hashConfigurationDirectory(dir string) (string, error) {
+	files, err := configurationFiles(dir)
+	if err != nil {
+		return "", err
+	}
+	return configurationHash(dir, files)
+}
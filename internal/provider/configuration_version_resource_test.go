@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccScalrConfigurationVersion_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+	dir := t.TempDir()
+	writeConfig := func(content string) {
+		if err := os.WriteFile(filepath.Join(dir, "main.tf"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeConfig(`resource "terraform_data" "test" {}`)
+
+	var firstID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// A missing directory passes the plan, as it may be created during apply, and fails on create.
+				Config:      testAccScalrConfigurationVersionConfig(rInt, filepath.Join(dir, "missing")),
+				ExpectError: regexp.MustCompile("Error reading configuration directory"),
+			},
+			{
+				Config: testAccScalrConfigurationVersionConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("scalr_configuration_version.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"scalr_configuration_version.test", "workspace_id",
+						"scalr_workspace.test", "id",
+					),
+					resource.TestCheckResourceAttr("scalr_configuration_version.test", "status", "uploaded"),
+					resource.TestCheckResourceAttr("scalr_configuration_version.test", "auto_queue_runs", "false"),
+					resource.TestCheckResourceAttr("scalr_configuration_version.test", "is_dry", "false"),
+					resource.TestCheckResourceAttrSet("scalr_configuration_version.test", "source_hash"),
+					func(s *terraform.State) error {
+						firstID = s.RootModule().Resources["scalr_configuration_version.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					writeConfig(`resource "terraform_data" "changed" {}`)
+				},
+				Config: testAccScalrConfigurationVersionConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_configuration_version.test", "status", "uploaded"),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources["scalr_configuration_version.test"].Primary.ID
+						if id == firstID {
+							return fmt.Errorf("expected a new configuration version after the files changed, got %s", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccScalrConfigurationVersion_directoryCreatedOnApply(t *testing.T) {
+	rInt := GetRandomInteger()
+	dir := filepath.Join(t.TempDir(), "generated")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "terraform_data" "generate" {
+  provisioner "local-exec" {
+    command = "mkdir -p %[2]s && echo 'resource \"terraform_data\" \"test\" {}' > %[2]s/main.tf"
+  }
+}
+
+resource "scalr_environment" "test" {
+  name       = "test-env-%[1]d"
+  account_id = "%[3]s"
+}
+
+resource "scalr_workspace" "test" {
+  name           = "configuration-version-test-%[1]d"
+  environment_id = scalr_environment.test.id
+}
+
+resource "scalr_configuration_version" "test" {
+  workspace_id = scalr_workspace.test.id
+  directory    = %[2]q
+  depends_on   = [terraform_data.generate]
+}`, rInt, dir, defaultAccount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_configuration_version.test", "status", "uploaded"),
+					resource.TestCheckResourceAttrSet("scalr_configuration_version.test", "source_hash"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScalrConfigurationVersionConfig(rInt int, dir string) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name       = "test-env-%[1]d"
+  account_id = "%[2]s"
+}
+
+resource "scalr_workspace" "test" {
+  name           = "configuration-version-test-%[1]d"
+  environment_id = scalr_environment.test.id
+}
+
+resource "scalr_configuration_version" "test" {
+  workspace_id = scalr_workspace.test.id
+  directory    = %[3]q
+}`, rInt, defaultAccount, dir)
+}
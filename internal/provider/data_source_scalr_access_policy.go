@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func dataSourceScalrAccessPolicy() *schema.Resource {
@@ -76,7 +78,7 @@ func dataSourceScalrAccessPolicy() *schema.Resource {
 }
 
 func dataSourceScalrAccessPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Get("id").(string)
 
 	log.Printf("[DEBUG] Read configuration of access policy: %s", id)
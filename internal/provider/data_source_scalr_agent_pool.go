@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func dataSourceScalrAgentPool() *schema.Resource {
@@ -102,7 +104,7 @@ func dataSourceScalrAgentPool() *schema.Resource {
 }
 
 func dataSourceScalrAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	agentPoolID := d.Get("id").(string)
 	name := d.Get("name").(string)
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
 
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 	"github.com/scalr/terraform-provider-scalr/internal/framework/defaults"
 )
 
@@ -34,7 +35,7 @@ func dataSourceScalrCurrentAccount() *schema.Resource {
 }
 
 func dataSourceScalrCurrentAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	accID, ok := getDefaultScalrAccountID()
 	if !ok {
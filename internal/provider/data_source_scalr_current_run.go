@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 const (
@@ -121,7 +123,7 @@ func dataSourceScalrCurrentRun() *schema.Resource {
 }
 
 func dataSourceScalrCurrentRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	runID, exists := os.LookupEnv(currentRunIDEnvVar)
 	if !exists {
@@ -8,6 +8,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccCurrentRun_basic(t *testing.T) {
@@ -46,12 +48,12 @@ func TestAccCurrentRun_basic(t *testing.T) {
 
 func launchRun(environmentName, workspaceName string) func() {
 	return func() {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		options := GetEnvironmentByNameOptions{
 			Name: &environmentName,
 		}
-		env, err := GetEnvironmentByName(ctx, options, scalrClient)
+		env, err := GetEnvironmentByName(ctx, options, scalrClient, nil)
 		if err != nil {
 			log.Fatalf("Got error during environment fetching: %v", err)
 			return
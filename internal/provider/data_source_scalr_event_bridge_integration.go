@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func dataSourceScalrEventBridgeIntegration() *schema.Resource {
@@ -58,7 +60,7 @@ func dataSourceScalrEventBridgeIntegration() *schema.Resource {
 }
 
 func dataSourceScalrEventBridgeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	// required fields
 	eventBridgeID := d.Get("id").(string)
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func dataSourceModuleVersion() *schema.Resource {
@@ -36,7 +38,7 @@ func dataSourceModuleVersion() *schema.Resource {
 }
 
 func dataSourceModuleVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	source := d.Get("source").(string)
 	module, err := scalrClient.Modules.ReadBySource(ctx, source)
@@ -8,6 +8,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccModuleVersionDataSource_basic(t *testing.T) {
@@ -55,13 +57,13 @@ func TestAccModuleVersionDataSource_basic(t *testing.T) {
 
 func waitForModuleVersions(environmentName string) func() {
 	return func() {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		options := GetEnvironmentByNameOptions{
 			Name: &environmentName,
 		}
 
-		env, err := GetEnvironmentByName(ctx, options, scalrClient)
+		env, err := GetEnvironmentByName(ctx, options, scalrClient, nil)
 		if err != nil {
 			log.Fatalf("Got error during environment fetching: %v", err)
 			return
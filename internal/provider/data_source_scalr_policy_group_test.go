@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccPolicyGroupDataSource_basic(t *testing.T) {
@@ -103,7 +105,7 @@ func TestAccPolicyGroupDataSource_basic(t *testing.T) {
 
 func waitForPolicyGroupFetch(name string) func() {
 	return func() {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		pgl, err := scalrClient.PolicyGroups.List(ctx, scalr.PolicyGroupListOptions{
 			Account: defaultAccount,
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func dataSourceScalrProviderConfigurations() *schema.Resource {
@@ -51,7 +53,7 @@ func dataSourceScalrProviderConfigurations() *schema.Resource {
 }
 
 func dataSourceScalrProviderConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	accountID := d.Get("account_id").(string)
 	name := d.Get("name").(string)
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"sort"
 
@@ -10,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func dataSourceScalrRole() *schema.Resource {
@@ -64,7 +67,8 @@ func dataSourceScalrRole() *schema.Resource {
 }
 
 func dataSourceScalrRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
 
 	// required fields
 	roleID := d.Get("id").(string)
@@ -84,7 +88,12 @@ func dataSourceScalrRoleRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	log.Printf("[DEBUG] Read configuration of role with ID '%s', name '%s', and account_id '%s'", roleID, name, accountID)
-	roles, err := scalrClient.Roles.List(ctx, options)
+	key := fmt.Sprintf("id=%s&name=%s&account=%s", roleID, name, accountID)
+	roles, err := framework.CachedLookup(ctx, clients.LookupCache, framework.LookupRoles, key,
+		func() (*scalr.RoleList, error) {
+			return scalrClient.Roles.List(ctx, options)
+		},
+	)
 	if err != nil {
 		return diag.Errorf("Error retrieving role: %v", err)
 	}
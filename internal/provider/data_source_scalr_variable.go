@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func dataSourceScalrVariable() *schema.Resource {
@@ -119,7 +121,7 @@ func dataSourceScalrVariable() *schema.Resource {
 }
 
 func dataSourceScalrVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	filters := scalr.VariableFilter{}
 	options := scalr.VariableListOptions{Filter: &filters, Include: ptr("updated-by")}
 
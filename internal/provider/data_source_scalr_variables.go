@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func dataSourceScalrVariables() *schema.Resource {
@@ -153,7 +155,7 @@ func dataSourceScalrVariables() *schema.Resource {
 }
 
 func dataSourceScalrVariablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	filters := scalr.VariableFilter{}
 	options := scalr.VariableListOptions{Filter: &filters, Include: ptr("updated-by")}
 
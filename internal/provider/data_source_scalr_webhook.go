@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func dataSourceScalrWebhook() *schema.Resource {
@@ -121,7 +123,8 @@ func dataSourceScalrWebhook() *schema.Resource {
 }
 
 func dataSourceScalrWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
 
 	// Get IDs
 	webhookID := d.Get("id").(string)
@@ -147,7 +150,7 @@ func dataSourceScalrWebhookRead(ctx context.Context, d *schema.ResourceData, met
 			Name:    &webhookName,
 			Account: &accountID,
 		}
-		webhook, err = GetWebhookByName(ctx, options, scalrClient)
+		webhook, err = GetWebhookByName(ctx, options, scalrClient, clients.LookupCache)
 		if err != nil {
 			return diag.Errorf("Error retrieving webhook: %v", err)
 		}
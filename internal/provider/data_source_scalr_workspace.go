@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"sort"
 
@@ -11,6 +12,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func dataSourceScalrWorkspace() *schema.Resource {
@@ -266,7 +269,8 @@ func dataSourceScalrWorkspace() *schema.Resource {
 }
 
 func dataSourceScalrWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
 
 	workspaceID := d.Get("id").(string)
 	name := d.Get("name").(string)
@@ -287,7 +291,12 @@ func dataSourceScalrWorkspaceRead(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[DEBUG] Read configuration of workspace with ID '%s', name '%s', and environment_id '%s'", workspaceID, name, environmentID)
 
-	workspaces, err := scalrClient.Workspaces.List(ctx, options)
+	key := fmt.Sprintf("id=%s&name=%s&environment=%s", workspaceID, name, environmentID)
+	workspaces, err := framework.CachedLookup(ctx, clients.LookupCache, framework.LookupWorkspaces, key,
+		func() (*scalr.WorkspaceList, error) {
+			return scalrClient.Workspaces.List(ctx, options)
+		},
+	)
 	if err != nil {
 		return diag.Errorf("error retrieving workspace: %v", err)
 	}
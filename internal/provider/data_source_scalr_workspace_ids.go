@@ -8,6 +8,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func dataSourceScalrWorkspaceIDs() *schema.Resource {
@@ -39,7 +41,7 @@ func dataSourceScalrWorkspaceIDs() *schema.Resource {
 }
 
 func dataSourceScalrWorkspaceIDsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	// Get the environment_id.
 	environmentID := d.Get("environment_id").(string)
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func dataSourceScalrWorkspaces() *schema.Resource {
@@ -51,7 +53,7 @@ func dataSourceScalrWorkspaces() *schema.Resource {
 }
 
 func dataSourceScalrWorkspacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	accountId := d.Get("account_id").(string)
 
 	options := scalr.WorkspaceListOptions{
@@ -60,6 +60,13 @@ type workspaceFiltersModel struct {
 	Tags             types.Set `tfsdk:"tags"`
 }
 
+// driftDetectionAPIErrorPaths maps the API error sources of the drift detection to its attributes.
+var driftDetectionAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/run-mode":          path.Root("run_mode"),
+	"/data/attributes/workspace-filters": path.Root("workspace_filters"),
+	"/data/relationships/environment":    path.Root("environment_id"),
+}
+
 func (r *driftDetectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_drift_detection"
 }
@@ -269,7 +276,10 @@ func (r *driftDetectionResource) Create(ctx context.Context, req resource.Create
 
 	driftDetection, err := r.ClientV2.DriftDetectionSchedule.CreateDriftDetectionSchedule(ctx, &opts, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating scalr_drift_detection", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error creating scalr_drift_detection", err,
+			framework.WithAttributePaths(driftDetectionAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -347,7 +357,10 @@ func (r *driftDetectionResource) Update(ctx context.Context, req resource.Update
 
 	driftDetection, err := r.ClientV2.DriftDetectionSchedule.UpdateDriftDetectionSchedule(ctx, plan.Id.ValueString(), &opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating scalr_drift_detection", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error updating scalr_drift_detection", err,
+			framework.WithAttributePaths(driftDetectionAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -372,7 +385,7 @@ func (r *driftDetectionResource) Delete(ctx context.Context, req resource.Delete
 
 	err := r.ClientV2.DriftDetectionSchedule.DeleteDriftDetectionSchedule(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, client.ErrNotFound) {
-		resp.Diagnostics.AddError("Error deleting scalr_drift_detection", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting scalr_drift_detection", err)...)
 		return
 	}
 }
@@ -9,7 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
-	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestDriftDetection_basic(t *testing.T) {
@@ -93,7 +94,7 @@ func TestDriftDetection_import(t *testing.T) {
 }
 
 func testDriftDetectionDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_drift_detection" {
@@ -115,7 +116,7 @@ func testDriftDetectionDestroy(s *terraform.State) error {
 
 func testDriftDetectionDeleted(name string, driftDetectionID *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		_, ok := s.RootModule().Resources[name]
 		if ok {
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/ops/run"
+	"github.com/scalr/go-scalr/v2/scalr/ops/workspace"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource              = &driftDetectionResultsDataSource{}
+	_ datasource.DataSourceWithConfigure = &driftDetectionResultsDataSource{}
+)
+
+func newDriftDetectionResultsDataSource() datasource.DataSource {
+	return &driftDetectionResultsDataSource{}
+}
+
+// driftDetectionResultsDataSource defines the data source implementation.
+type driftDetectionResultsDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// driftDetectionResultsDataSourceModel describes the data source data model.
+type driftDetectionResultsDataSourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	EnvironmentID       types.String `tfsdk:"environment_id"`
+	DriftedOnly         types.Bool   `tfsdk:"drifted_only"`
+	DriftedWorkspaceIDs types.Set    `tfsdk:"drifted_workspace_ids"`
+	Workspaces          types.List   `tfsdk:"workspaces"`
+}
+
+// driftDetectionResultModel describes the latest drift check of a single workspace.
+type driftDetectionResultModel struct {
+	WorkspaceID      types.String `tfsdk:"workspace_id"`
+	WorkspaceName    types.String `tfsdk:"workspace_name"`
+	Status           types.String `tfsdk:"status"`
+	Drifted          types.Bool   `tfsdk:"drifted"`
+	RunID            types.String `tfsdk:"run_id"`
+	DetectedAt       types.String `tfsdk:"detected_at"`
+	DriftedResources types.List   `tfsdk:"drifted_resources"`
+}
+
+// driftedResourceModel describes a resource that drifted from the state.
+type driftedResourceModel struct {
+	Address types.String `tfsdk:"address"`
+	Actions types.List   `tfsdk:"actions"`
+}
+
+var driftedResourceElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"address": types.StringType,
+		"actions": types.ListType{ElemType: types.StringType},
+	},
+}
+
+var driftDetectionResultElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"workspace_id":      types.StringType,
+		"workspace_name":    types.StringType,
+		"status":            types.StringType,
+		"drifted":           types.BoolType,
+		"run_id":            types.StringType,
+		"detected_at":       types.StringType,
+		"drifted_resources": types.ListType{ElemType: driftedResourceElementType},
+	},
+}
+
+func (d *driftDetectionResultsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_drift_detection_results"
+}
+
+func (d *driftDetectionResultsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the latest drift detection result of each workspace in an environment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of this data source.",
+				Computed:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment, in the format `env-<RANDOM STRING>`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"drifted_only": schema.BoolAttribute{
+				MarkdownDescription: "Only return workspaces with a detected drift that awaits an action. Defaults to `false`.",
+				Optional:            true,
+			},
+			"drifted_workspace_ids": schema.SetAttribute{
+				MarkdownDescription: "The list of IDs of the workspaces with a detected drift that awaits an action.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"workspaces": schema.ListAttribute{
+				MarkdownDescription: "The latest drift check of each workspace that has been checked, sorted by workspace name." +
+					" Each entry has the `workspace_id`, `workspace_name`, the drift report `status`," +
+					" whether the workspace has `drifted`, the `run_id` of the check run and the `detected_at` time" +
+					" in RFC3339 format. The API does not report when the drift was first detected, so `detected_at`" +
+					" is the time the drift report was last updated. For a drifted workspace, `drifted_resources` holds the `address`" +
+					" and the change `actions` of each drifted resource.",
+				ElementType: driftDetectionResultElementType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *driftDetectionResultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg driftDetectionResultsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envID := cfg.EnvironmentID.ValueString()
+	driftedOnly := cfg.DriftedOnly.ValueBool()
+
+	opts := &workspace.GetWorkspacesOptions{
+		Filter:   map[string]string{"environment": envID},
+		Include:  []string{"drift-report"},
+		PageSize: 100,
+	}
+
+	results := make([]driftDetectionResultModel, 0)
+	driftedIDs := make([]string, 0)
+	for ws, err := range d.ClientV2.Workspace.GetWorkspacesIter(ctx, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving workspaces", err.Error())
+			return
+		}
+
+		report := ws.Relationships.DriftReport
+		if report == nil || report.Attributes.Status == "" {
+			// The workspace has not been checked for drift yet.
+			continue
+		}
+
+		drifted := report.Attributes.Status == schemas.DriftReportStatusAwaitingManualAction
+		if driftedOnly && !drifted {
+			continue
+		}
+
+		result := driftDetectionResultModel{
+			WorkspaceID:      types.StringValue(ws.ID),
+			WorkspaceName:    types.StringValue(ws.Attributes.Name),
+			Status:           types.StringValue(string(report.Attributes.Status)),
+			Drifted:          types.BoolValue(drifted),
+			RunID:            types.StringNull(),
+			DetectedAt:       types.StringNull(),
+			DriftedResources: types.ListValueMust(driftedResourceElementType, []attr.Value{}),
+		}
+		if report.Attributes.UpdatedAt != nil {
+			result.DetectedAt = types.StringValue(report.Attributes.UpdatedAt.Format(time.RFC3339))
+		}
+		if report.Relationships.Run != nil {
+			result.RunID = types.StringValue(report.Relationships.Run.ID)
+		}
+
+		if drifted {
+			driftedIDs = append(driftedIDs, ws.ID)
+			if !result.RunID.IsNull() {
+				resources, diags := d.readDriftedResources(ctx, result.RunID.ValueString())
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				result.DriftedResources = resources
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].WorkspaceName.ValueString() < results[j].WorkspaceName.ValueString()
+	})
+
+	cfg.Id = types.StringValue(fmt.Sprintf("%d", framework.HashString(envID+cfg.DriftedOnly.String())))
+
+	driftedIDsValue, diags := types.SetValueFrom(ctx, types.StringType, driftedIDs)
+	resp.Diagnostics.Append(diags...)
+	cfg.DriftedWorkspaceIDs = driftedIDsValue
+
+	resultsValue, diags := types.ListValueFrom(ctx, driftDetectionResultElementType, results)
+	resp.Diagnostics.Append(diags...)
+	cfg.Workspaces = resultsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// readDriftedResources lists the resources reported by the plan of the drift check run.
+func (d *driftDetectionResultsDataSource) readDriftedResources(ctx context.Context, runID string) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	empty := types.ListValueMust(driftedResourceElementType, []attr.Value{})
+
+	r, err := d.ClientV2.Run.GetRun(ctx, runID, &run.GetRunOptions{Include: []string{"plan"}})
+	if err != nil {
+		diags.AddWarning(
+			"Error retrieving drift check run",
+			fmt.Sprintf("Could not read run %s, drifted resources are not available: %v", runID, err),
+		)
+		return empty, diags
+	}
+
+	p := r.Relationships.Plan
+	if p == nil || p.Attributes.Status != schemas.PlanStatusFinished {
+		return empty, diags
+	}
+
+	raw, err := d.ClientV2.Plan.GetSanitizedJsonOutput(ctx, p.ID, nil)
+	if err != nil {
+		diags.AddWarning(
+			"Error retrieving plan output",
+			fmt.Sprintf("Could not read the JSON output of plan %s, drifted resources are not available: %v", p.ID, err),
+		)
+		return empty, diags
+	}
+
+	changes, err := driftedResourceChanges([]byte(raw))
+	if err != nil {
+		diags.AddWarning(
+			"Error decoding plan output",
+			fmt.Sprintf("Could not decode the JSON output of plan %s, drifted resources are not available: %v", p.ID, err),
+		)
+		return empty, diags
+	}
+
+	resources := make([]driftedResourceModel, 0, len(changes))
+	for _, rc := range changes {
+		actions, listDiags := types.ListValueFrom(ctx, types.StringType, rc.Change.Actions)
+		diags.Append(listDiags...)
+		resources = append(resources, driftedResourceModel{
+			Address: types.StringValue(rc.Address),
+			Actions: actions,
+		})
+	}
+
+	resourcesValue, listDiags := types.ListValueFrom(ctx, driftedResourceElementType, resources)
+	diags.Append(listDiags...)
+	return resourcesValue, diags
+}
+
+// driftedResourceChanges returns the changes of the drifted resources from the JSON plan.
+// Refresh-only checks report the drift in `resource_drift`, while plan checks
+// report the changes required to reconcile the infrastructure in `resource_changes`.
+// Resources without changes are skipped.
+func driftedResourceChanges(raw []byte) ([]planResourceChange, error) {
+	var out planJSON
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+
+	changes := out.ResourceDrift
+	if len(changes) == 0 {
+		changes = out.ResourceChanges
+	}
+
+	drifted := make([]planResourceChange, 0, len(changes))
+	for _, rc := range changes {
+		if len(rc.Change.Actions) == 1 && (rc.Change.Actions[0] == "no-op" || rc.Change.Actions[0] == "read") {
+			continue
+		}
+		drifted = append(drifted, rc)
+	}
+	return drifted, nil
+}
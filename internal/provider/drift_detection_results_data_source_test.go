@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrDriftDetectionResultsDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrDriftDetectionResultsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_drift_detection_results.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_drift_detection_results.test", "environment_id",
+						"scalr_environment.test", "id",
+					),
+					// A new workspace has not been checked for drift yet.
+					resource.TestCheckResourceAttr("data.scalr_drift_detection_results.test", "workspaces.#", "0"),
+					resource.TestCheckResourceAttr("data.scalr_drift_detection_results.test", "drifted_workspace_ids.#", "0"),
+					resource.TestCheckResourceAttr("data.scalr_drift_detection_results.drifted", "workspaces.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDriftedResourceChanges(t *testing.T) {
+	for name, tc := range map[string]struct {
+		plan string
+		want []string
+	}{
+		"refresh-only drift": {
+			plan: `{
+  "resource_drift": [
+    {"address": "aws_instance.web", "change": {"actions": ["update"]}},
+    {"address": "aws_s3_bucket.logs", "change": {"actions": ["delete"]}},
+    {"address": "aws_iam_role.app", "change": {"actions": ["no-op"]}}
+  ],
+  "resource_changes": [
+    {"address": "aws_instance.web", "change": {"actions": ["no-op"]}}
+  ]
+}`,
+			want: []string{"aws_instance.web: update", "aws_s3_bucket.logs: delete"},
+		},
+		"plan changes": {
+			plan: `{
+  "resource_changes": [
+    {"address": "aws_instance.web", "change": {"actions": ["update"]}},
+    {"address": "aws_s3_bucket.logs", "change": {"actions": ["delete", "create"]}},
+    {"address": "aws_iam_role.app", "change": {"actions": ["no-op"]}},
+    {"address": "data.aws_ami.ubuntu", "change": {"actions": ["read"]}}
+  ]
+}`,
+			want: []string{"aws_instance.web: update", "aws_s3_bucket.logs: delete,create"},
+		},
+		"no drift": {
+			plan: `{"resource_changes": [{"address": "aws_instance.web", "change": {"actions": ["no-op"]}}]}`,
+			want: []string{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			changes, err := driftedResourceChanges([]byte(tc.plan))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := make([]string, 0, len(changes))
+			for _, rc := range changes {
+				got = append(got, rc.Address+": "+strings.Join(rc.Change.Actions, ","))
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("unexpected drifted resources:\n got: %v\nwant: %v", got, tc.want)
+			}
+		})
+	}
+
+	if _, err := driftedResourceChanges([]byte("not a plan")); err == nil {
+		t.Error("expected an error for an invalid plan")
+	}
+}
+
+func testAccScalrDriftDetectionResultsDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource scalr_environment test {
+  name       = "test-env-drift-results-%d"
+  account_id = "%s"
+}
+
+resource scalr_workspace test {
+  name           = "test-ws-drift-results-%[1]d"
+  environment_id = scalr_environment.test.id
+}
+
+data scalr_drift_detection_results test {
+  environment_id = scalr_environment.test.id
+  depends_on     = [scalr_workspace.test]
+}
+
+data scalr_drift_detection_results drifted {
+  environment_id = scalr_environment.test.id
+  drifted_only   = true
+  depends_on     = [scalr_workspace.test]
+}`, rInt, defaultAccount)
+}
@@ -177,7 +177,7 @@ func (d *environmentDataSource) Read(ctx context.Context, req datasource.ReadReq
 			Account: cfg.AccountID.ValueStringPointer(),
 			Include: ptr("created-by"),
 		}
-		environment, err = GetEnvironmentByName(ctx, options, d.Client)
+		environment, err = GetEnvironmentByName(ctx, options, d.Client, d.LookupCache)
 		if err != nil {
 			resp.Diagnostics.AddError("Error retrieving environment", err.Error())
 			return
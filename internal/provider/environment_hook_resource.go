@@ -139,7 +139,7 @@ func (r *environmentHookResource) Create(ctx context.Context, req resource.Creat
 
 	envHook, err := r.Client.EnvironmentHooks.Create(ctx, opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating environment hook", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error creating environment hook", err)...)
 		return
 	}
 
@@ -213,7 +213,7 @@ func (r *environmentHookResource) Update(ctx context.Context, req resource.Updat
 
 	envHook, err := r.Client.EnvironmentHooks.Update(ctx, state.Id.ValueString(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating environment hook", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error updating environment hook", err)...)
 		return
 	}
 
@@ -238,7 +238,7 @@ func (r *environmentHookResource) Delete(ctx context.Context, req resource.Delet
 
 	err := r.Client.EnvironmentHooks.Delete(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, scalr.ErrResourceNotFound) {
-		resp.Diagnostics.AddError("Error deleting environment hook", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting environment hook", err)...)
 		return
 	}
 }
@@ -3,9 +3,13 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,18 +22,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/scalr/go-scalr"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/ops/workspace"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
 
 	"github.com/scalr/terraform-provider-scalr/internal/framework"
 	"github.com/scalr/terraform-provider-scalr/internal/framework/defaults"
 	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
 )
 
+const (
+	environmentOnDeleteBlock   = "block"
+	environmentOnDeleteCascade = "cascade"
+	environmentOnDeleteWait    = "wait"
+
+	environmentDeleteTimeout      = 10 * time.Minute
+	environmentDeletePollInterval = 5 * time.Second
+)
+
 // Compile-time interface checks
 var (
 	_ resource.Resource                = &environmentResource{}
 	_ resource.ResourceWithConfigure   = &environmentResource{}
 	_ resource.ResourceWithImportState = &environmentResource{}
+	_ resource.ResourceWithModifyPlan  = &environmentResource{}
 )
 
 func newEnvironmentResource() resource.Resource {
@@ -57,9 +76,16 @@ type environmentResourceModel struct {
 	AccountID                     types.String `tfsdk:"account_id"`
 	StorageProfileID              types.String `tfsdk:"storage_profile_id"`
 	DefaultWorkspaceAgentPoolID   types.String `tfsdk:"default_workspace_agent_pool_id"`
+	OnDelete                      types.String `tfsdk:"on_delete"`
+	ManageTags                    types.Bool   `tfsdk:"manage_tags"`
 }
 
-func environmentResourceModelFromAPI(ctx context.Context, env *scalr.Environment, federatedEnvironments []string) (*environmentResourceModel, diag.Diagnostics) {
+func environmentResourceModelFromAPI(
+	ctx context.Context,
+	env *scalr.Environment,
+	federatedEnvironments []string,
+	existing *environmentResourceModel,
+) (*environmentResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	model := &environmentResourceModel{
@@ -77,6 +103,16 @@ func environmentResourceModelFromAPI(ctx context.Context, env *scalr.Environment
 		AccountID:                     types.StringValue(env.Account.ID),
 		StorageProfileID:              types.StringNull(),
 		DefaultWorkspaceAgentPoolID:   types.StringNull(),
+		OnDelete:                      types.StringNull(),
+		ManageTags:                    types.BoolValue(true),
+	}
+
+	if existing != nil {
+		// These attributes are not returned by the API.
+		model.OnDelete = existing.OnDelete
+		if !existing.ManageTags.IsNull() {
+			model.ManageTags = existing.ManageTags
+		}
 	}
 
 	if env.CreatedBy != nil {
@@ -103,9 +139,12 @@ func environmentResourceModelFromAPI(ctx context.Context, env *scalr.Environment
 	diags.Append(d...)
 	model.DefaultProviderConfigurations = defaultPcfgsValue
 
-	tags := make([]string, len(env.Tags))
-	for i, tag := range env.Tags {
-		tags[i] = tag.ID
+	// Tags of an unmanaged environment are left out, as they are not configured in `tag_ids`.
+	tags := make([]string, 0, len(env.Tags))
+	if model.ManageTags.ValueBool() {
+		for _, tag := range env.Tags {
+			tags = append(tags, tag.ID)
+		}
 	}
 	tagsValue, d := types.SetValueFrom(ctx, types.StringType, tags)
 	diags.Append(d...)
@@ -198,6 +237,15 @@ func (r *environmentResource) Schema(ctx context.Context, _ resource.SchemaReque
 					setvalidator.ValueStringsAre(stringvalidation.StringIsNotWhiteSpace()),
 				},
 			},
+			"manage_tags": schema.BoolAttribute{
+				MarkdownDescription: "Whether the tags of the environment are managed by this resource." +
+					" If `true` (default), `tag_ids` is authoritative, and omitting it removes all tags from the environment." +
+					" Set it to `false` to leave the tags as they are, e.g. to attach them with the `scalr_environment_tag`" +
+					" resource instead; `tag_ids` cannot be set then.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
 			"remote_backend": schema.BoolAttribute{
 				MarkdownDescription: "If Scalr exports the remote backend configuration and state storage for your infrastructure management." +
 					" Disabling this feature will also prevent the ability to perform state locking, which ensures that concurrent operations do not conflict." +
@@ -248,11 +296,24 @@ func (r *environmentResource) Schema(ctx context.Context, _ resource.SchemaReque
 				MarkdownDescription: "Default agent pool that will be set for the entire environment. It will be used by a workspace if no other pool is explicitly linked.",
 				Optional:            true,
 			},
+			"on_delete": schema.StringAttribute{
+				MarkdownDescription: "How to handle the workspaces that are still in the environment when it is destroyed." +
+					" `block` fails and lists the workspaces that prevent the deletion." +
+					" `cascade` deletes the workspaces first; it fails without deleting anything if any of them has deletion protection enabled." +
+					" `wait` deletes the environment and waits until it is fully removed." +
+					" If not set, the environment is deleted with a single API call.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(environmentOnDeleteBlock, environmentOnDeleteCascade, environmentOnDeleteWait),
+				},
+			},
 		},
 	}
 }
 
 func (r *environmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupEnvironments)
+
 	var plan environmentResourceModel
 
 	// Read plan data
@@ -287,7 +348,7 @@ func (r *environmentResource) Create(ctx context.Context, req resource.CreateReq
 		opts.DefaultProviderConfigurations = defaultPcfgs
 	}
 
-	if !plan.TagIDs.IsUnknown() && !plan.TagIDs.IsNull() {
+	if plan.ManageTags.ValueBool() && !plan.TagIDs.IsUnknown() && !plan.TagIDs.IsNull() {
 		var tagIDs []string
 		resp.Diagnostics.Append(plan.TagIDs.ElementsAs(ctx, &tagIDs, false)...)
 
@@ -324,7 +385,7 @@ func (r *environmentResource) Create(ctx context.Context, req resource.CreateReq
 
 	environment, err := r.Client.Environments.Create(ctx, opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating environment", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error creating environment", err)...)
 		return
 	}
 
@@ -348,7 +409,7 @@ func (r *environmentResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Error retrieving federated environments", err.Error())
 	}
 
-	result, diags := environmentResourceModelFromAPI(ctx, environment, federated)
+	result, diags := environmentResourceModelFromAPI(ctx, environment, federated, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -385,7 +446,7 @@ func (r *environmentResource) Read(ctx context.Context, req resource.ReadRequest
 		resp.Diagnostics.AddError("Error retrieving federated environments", err.Error())
 	}
 
-	result, diags := environmentResourceModelFromAPI(ctx, environment, federated)
+	result, diags := environmentResourceModelFromAPI(ctx, environment, federated, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -399,6 +460,8 @@ func (r *environmentResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *environmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupEnvironments)
+
 	var plan, state environmentResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -475,11 +538,11 @@ func (r *environmentResource) Update(ctx context.Context, req resource.UpdateReq
 	// Update existing resource
 	_, err := r.Client.Environments.Update(ctx, plan.Id.ValueString(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating environment", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error updating environment", err)...)
 		return
 	}
 
-	if !plan.TagIDs.Equal(state.TagIDs) {
+	if plan.ManageTags.ValueBool() && !plan.TagIDs.Equal(state.TagIDs) {
 		var planTags []string
 		var stateTags []string
 		resp.Diagnostics.Append(plan.TagIDs.ElementsAs(ctx, &planTags, false)...)
@@ -543,7 +606,7 @@ func (r *environmentResource) Update(ctx context.Context, req resource.UpdateReq
 		resp.Diagnostics.AddError("Error retrieving federated environments", err.Error())
 	}
 
-	result, diags := environmentResourceModelFromAPI(ctx, environment, federated)
+	result, diags := environmentResourceModelFromAPI(ctx, environment, federated, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -557,6 +620,9 @@ func (r *environmentResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *environmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Workspaces are deleted along with their environment.
+	defer r.LookupCache.Invalidate(framework.LookupEnvironments, framework.LookupWorkspaces)
+
 	// Get current state
 	var state environmentResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -564,11 +630,175 @@ func (r *environmentResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := r.Client.Environments.Delete(ctx, state.Id.ValueString())
-	if err != nil && !errors.Is(err, scalr.ErrResourceNotFound) {
-		resp.Diagnostics.AddError("Error deleting environment", err.Error())
+	envID := state.Id.ValueString()
+
+	switch state.OnDelete.ValueString() {
+	case environmentOnDeleteBlock:
+		workspaces, err := r.listEnvironmentWorkspaces(ctx, envID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving environment workspaces", err.Error())
+			return
+		}
+		if len(workspaces) > 0 {
+			resp.Diagnostics.AddError(
+				"Error deleting environment",
+				fmt.Sprintf(
+					"Environment %s still contains %d workspace(s):\n\n%s\n\n"+
+						"Delete them first, or set `on_delete` to `cascade` to delete them along with the environment.",
+					envID, len(workspaces), formatWorkspaceList(workspaces),
+				),
+			)
+			return
+		}
+	case environmentOnDeleteCascade:
+		resp.Diagnostics.Append(r.deleteEnvironmentWorkspaces(ctx, envID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := r.Client.Environments.Delete(ctx, envID)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound) {
+			return
+		}
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting environment", err)...)
+		return
+	}
+
+	if state.OnDelete.ValueString() == environmentOnDeleteWait {
+		stateConf := &retry.StateChangeConf{
+			Pending: []string{"exists"},
+			Target:  []string{"deleted"},
+			Refresh: func() (interface{}, string, error) {
+				env, err := r.Client.Environments.Read(ctx, envID)
+				if err != nil {
+					if errors.Is(err, scalr.ErrResourceNotFound) {
+						return envID, "deleted", nil
+					}
+					return nil, "", err
+				}
+				return env, "exists", nil
+			},
+			Timeout:    environmentDeleteTimeout,
+			MinTimeout: environmentDeletePollInterval,
+		}
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			resp.Diagnostics.AddError("Error waiting for environment deletion", err.Error())
+		}
+	}
+}
+
+// deleteEnvironmentWorkspaces deletes all workspaces in the environment and waits until they are gone.
+// Nothing is deleted if any of the workspaces has deletion protection enabled.
+func (r *environmentResource) deleteEnvironmentWorkspaces(ctx context.Context, envID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	workspaces, err := r.listEnvironmentWorkspaces(ctx, envID)
+	if err != nil {
+		diags.AddError("Error retrieving environment workspaces", err.Error())
+		return diags
+	}
+
+	var protected []*schemas.Workspace
+	for _, ws := range workspaces {
+		if ws.Attributes.DeletionProtectionEnabled {
+			protected = append(protected, ws)
+		}
+	}
+	if len(protected) > 0 {
+		diags.AddError(
+			"Error deleting environment",
+			fmt.Sprintf(
+				"Environment %s contains %d workspace(s) with deletion protection enabled:\n\n%s\n\n"+
+					"Disable the deletion protection on these workspaces to delete them along with the environment.",
+				envID, len(protected), formatWorkspaceList(protected),
+			),
+		)
+		return diags
+	}
+
+	for _, ws := range workspaces {
+		tflog.Debug(ctx, "Deleting environment workspace", map[string]interface{}{"workspace_id": ws.ID})
+		err = r.ClientV2.Workspace.DeleteWorkspace(ctx, ws.ID)
+		if err != nil && !errors.Is(err, client.ErrNotFound) {
+			diags.Append(framework.APIErrorDiagnostics(
+				fmt.Sprintf("Error deleting workspace %s", ws.ID), err,
+				framework.WithRequiredPermission("workspaces:delete"),
+			)...)
+			return diags
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"exists"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			remaining, err := r.listEnvironmentWorkspaces(ctx, envID)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(remaining) > 0 {
+				return remaining, "exists", nil
+			}
+			return remaining, "deleted", nil
+		},
+		Timeout:    environmentDeleteTimeout,
+		MinTimeout: environmentDeletePollInterval,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		diags.AddError("Error waiting for environment workspaces deletion", err.Error())
+	}
+
+	return diags
+}
+
+func (r *environmentResource) listEnvironmentWorkspaces(ctx context.Context, envID string) ([]*schemas.Workspace, error) {
+	var workspaces []*schemas.Workspace
+
+	opts := &workspace.GetWorkspacesOptions{
+		Filter: map[string]string{"environment": envID},
+	}
+	for ws, err := range r.ClientV2.Workspace.GetWorkspacesIter(ctx, opts) {
+		if err != nil {
+			return nil, err
+		}
+		workspaces = append(workspaces, &ws)
+	}
+
+	return workspaces, nil
+}
+
+func formatWorkspaceList(workspaces []*schemas.Workspace) string {
+	lines := make([]string, len(workspaces))
+	for i, ws := range workspaces {
+		lines[i] = fmt.Sprintf("  - %s (%s)", ws.Attributes.Name, ws.ID)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r *environmentResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		// The resource is being destroyed
 		return
 	}
+
+	var manageTags types.Bool
+	var tagsCfg types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("manage_tags"), &manageTags)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tag_ids"), &tagsCfg)...)
+	if !manageTags.IsUnknown() && !manageTags.ValueBool() && !tagsCfg.IsNull() {
+		// The tags are not managed, e.g. they are attached with `scalr_environment_tag`.
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tag_ids"),
+			"Tags are not managed",
+			"The `tag_ids` attribute cannot be set while `manage_tags` is `false`.",
+		)
+	}
 }
 
 func (r *environmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -1,13 +1,17 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccEnvironment_basic(t *testing.T) {
@@ -169,8 +173,140 @@ func TestAccEnvironment_Federated(t *testing.T) {
 	})
 }
 
+func TestAccEnvironment_OnDeleteCascade(t *testing.T) {
+	environment := &scalr.Environment{}
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentOnDeleteConfig(rInt, "cascade"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrEnvironmentExists("scalr_environment.test", environment),
+					resource.TestCheckResourceAttr("scalr_environment.test", "on_delete", "cascade"),
+					testAccCreateUnmanagedWorkspace(environment, rInt, nil),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEnvironment_OnDeleteBlock(t *testing.T) {
+	environment := &scalr.Environment{}
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentOnDeleteConfig(rInt, "block"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrEnvironmentExists("scalr_environment.test", environment),
+					resource.TestCheckResourceAttr("scalr_environment.test", "on_delete", "block"),
+					testAccCreateUnmanagedWorkspace(environment, rInt, nil),
+				),
+			},
+			{
+				Config:  testAccEnvironmentOnDeleteConfig(rInt, "block"),
+				Destroy: true,
+				ExpectError: regexp.MustCompile(
+					fmt.Sprintf(`(?s)still contains 1 workspace\(s\):.*test-unmanaged-ws-%d`, rInt),
+				),
+			},
+			{
+				// Let the final destroy remove the blocking workspace.
+				Config: testAccEnvironmentOnDeleteConfig(rInt, "cascade"),
+				Check:  resource.TestCheckResourceAttr("scalr_environment.test", "on_delete", "cascade"),
+			},
+		},
+	})
+}
+
+func TestAccEnvironment_OnDeleteWait(t *testing.T) {
+	environment := &scalr.Environment{}
+	workspace := &scalr.Workspace{}
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckScalrEnvironmentDestroy,
+			testAccCheckUnmanagedWorkspaceDestroyed(workspace),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentOnDeleteConfig(rInt, "wait"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrEnvironmentExists("scalr_environment.test", environment),
+					resource.TestCheckResourceAttr("scalr_environment.test", "on_delete", "wait"),
+					testAccCreateUnmanagedWorkspace(environment, rInt, workspace),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEnvironment_OnDeleteInvalid(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEnvironmentOnDeleteConfig(rInt, "force"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Attribute on_delete value must be one of"),
+			},
+		},
+	})
+}
+
+// testAccCreateUnmanagedWorkspace creates a workspace in the environment outside Terraform.
+// The created workspace is stored in ws, if it is not nil.
+func testAccCreateUnmanagedWorkspace(environment *scalr.Environment, rInt int, ws *scalr.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
+
+		created, err := scalrClient.Workspaces.Create(ctx, scalr.WorkspaceCreateOptions{
+			Name:        ptr(fmt.Sprintf("test-unmanaged-ws-%d", rInt)),
+			Environment: &scalr.Environment{ID: environment.ID},
+		})
+		if err != nil {
+			return err
+		}
+		if ws != nil {
+			*ws = *created
+		}
+		return nil
+	}
+}
+
+// testAccCheckUnmanagedWorkspaceDestroyed checks that the workspace created outside Terraform
+// is gone as soon as the environment is destroyed.
+func testAccCheckUnmanagedWorkspaceDestroyed(ws *scalr.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
+
+		_, err := scalrClient.Workspaces.ReadByID(ctx, ws.ID)
+		if err == nil {
+			return fmt.Errorf("Workspace %s still exists", ws.ID)
+		}
+		if !errors.Is(err, scalr.ErrResourceNotFound) {
+			return err
+		}
+		return nil
+	}
+}
+
 func testAccCheckScalrEnvironmentDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_environment" {
@@ -192,7 +328,7 @@ func testAccCheckScalrEnvironmentDestroy(s *terraform.State) error {
 
 func testAccCheckScalrEnvironmentExists(n string, environment *scalr.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -216,7 +352,7 @@ func testAccCheckScalrEnvironmentExists(n string, environment *scalr.Environment
 func testAccCheckScalrEnvironmentFederation(
 	n string, isFederated bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -267,7 +403,7 @@ func testAccCheckScalrEnvironmentAttributesUpdate(environment *scalr.Environment
 
 func testAccCheckScalrEnvironmentProviderConfigurations(environment *scalr.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		if len(environment.DefaultProviderConfigurations) != 1 {
 			return fmt.Errorf("Bad default provider configurations: %v", environment.DefaultProviderConfigurations)
@@ -284,7 +420,7 @@ func testAccCheckScalrEnvironmentProviderConfigurations(environment *scalr.Envir
 }
 func testAccCheckScalrEnvironmentProviderConfigurationsUpdate(environment *scalr.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		if len(environment.DefaultProviderConfigurations) != 1 {
 			return fmt.Errorf("Bad default provider configurations: %v", environment.DefaultProviderConfigurations)
@@ -431,3 +567,12 @@ resource "scalr_environment" "test" {
   account_id                 = "%s"
 }`, rInt, defaultAccount)
 }
+
+func testAccEnvironmentOnDeleteConfig(rInt int, onDelete string) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name       = "test-env-%d"
+  account_id = "%s"
+  on_delete  = "%s"
+}`, rInt, defaultAccount, onDelete)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	scalrV2 "github.com/scalr/go-scalr/v2/scalr"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+)
+
+func newEnvironmentTagResource() resource.Resource {
+	return &tagLinkResource{
+		cfg: tagLinkConfig{
+			typeName:          "environment_tag",
+			object:            "environment",
+			objectAttribute:   "environment_id",
+			objectDescription: "ID of the environment, in the format `env-<RANDOM STRING>`.",
+			description: "Attaches a tag to an environment in Scalr. Unlike `scalr_environment.tag_ids`, this resource" +
+				" is non-authoritative: it manages a single tag and leaves the other tags of the environment untouched." +
+				"\n\n~> **Note:** Set `manage_tags = false` on the `scalr_environment` resource of the tagged environment," +
+				" otherwise it will remove the tags attached with this resource.",
+			lookupCache: framework.LookupEnvironments,
+			permission:  "environments:update",
+			addTags: func(ctx context.Context, c *scalrV2.Client, id string, tags []schemas.Tag) error {
+				return c.Environment.AddEnvironmentTags(ctx, id, tags)
+			},
+			listTags: func(ctx context.Context, c *scalrV2.Client, id string) iter.Seq2[schemas.Tag, error] {
+				return c.Environment.ListEnvironmentTagsIter(ctx, id, nil)
+			},
+			deleteTags: func(ctx context.Context, c *scalrV2.Client, id string, tags []schemas.Tag) error {
+				return c.Environment.DeleteEnvironmentTags(ctx, id, tags)
+			},
+		},
+	}
+}
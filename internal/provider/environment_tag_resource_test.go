@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccScalrEnvironmentTag_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrEnvironmentTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrEnvironmentTagConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrEnvironmentTagExists("scalr_environment_tag.foo"),
+					testAccCheckScalrEnvironmentTagExists("scalr_environment_tag.bar"),
+					resource.TestCheckResourceAttrPair(
+						"scalr_environment_tag.foo", "environment_id",
+						"scalr_environment.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"scalr_environment_tag.foo", "tag_id",
+						"scalr_tag.foo", "id",
+					),
+				),
+			},
+			{
+				// The environment doesn't manage its tags, so the attached ones cause no drift.
+				Config: testAccScalrEnvironmentTagConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_environment.test", "manage_tags", "false"),
+					resource.TestCheckResourceAttr("scalr_environment.test", "tag_ids.#", "0"),
+				),
+			},
+			{
+				Config:   testAccScalrEnvironmentTagConfig(rInt),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccScalrEnvironmentTagConfig(rInt) + testAccScalrEnvironmentTagUnmanagedConfig(rInt),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Tags are not managed"),
+			},
+		},
+	})
+}
+
+func TestAccScalrEnvironmentTag_import(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrEnvironmentTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrEnvironmentTagConfig(rInt),
+			},
+			{
+				ResourceName:      "scalr_environment_tag.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScalrEnvironmentTagExists(resID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		scalrClient := createScalrClientV2()
+
+		rs, ok := s.RootModule().Resources[resID]
+		if !ok {
+			return fmt.Errorf("not found: %s", resID)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no instance ID is set")
+		}
+
+		parts := strings.SplitN(rs.Primary.ID, "/", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid ID format: %s", rs.Primary.ID)
+		}
+		environmentID, tagID := parts[0], parts[1]
+
+		tags, err := scalrClient.Environment.ListEnvironmentTags(ctx, environmentID, nil)
+		if err != nil {
+			return fmt.Errorf("error listing tags for environment %s: %w", environmentID, err)
+		}
+
+		for _, tag := range tags {
+			if tag.ID == tagID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("tag %s not attached to environment %s", tagID, environmentID)
+	}
+}
+
+func testAccCheckScalrEnvironmentTagDestroy(s *terraform.State) error {
+	scalrClient := createScalrClientV2()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "scalr_environment_tag" {
+			continue
+		}
+		if rs.Primary.ID == "" {
+			continue
+		}
+
+		parts := strings.SplitN(rs.Primary.ID, "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		environmentID, tagID := parts[0], parts[1]
+
+		tags, err := scalrClient.Environment.ListEnvironmentTags(ctx, environmentID, nil)
+		if err != nil {
+			// Environment may already be deleted — treat as success.
+			continue
+		}
+
+		for _, tag := range tags {
+			if tag.ID == tagID {
+				return fmt.Errorf("tag %s still attached to environment %s", tagID, environmentID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccScalrEnvironmentTagConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name        = "test-env-%d"
+  account_id  = "%s"
+  manage_tags = false
+}
+
+resource "scalr_tag" "foo" {
+  name = "test-tag-foo-%[1]d"
+}
+
+resource "scalr_tag" "bar" {
+  name = "test-tag-bar-%[1]d"
+}
+
+resource "scalr_environment_tag" "foo" {
+  environment_id = scalr_environment.test.id
+  tag_id         = scalr_tag.foo.id
+}
+
+resource "scalr_environment_tag" "bar" {
+  environment_id = scalr_environment.test.id
+  tag_id         = scalr_tag.bar.id
+}
+`, rInt, defaultAccount)
+}
+
+// testAccScalrEnvironmentTagUnmanagedConfig adds an environment that sets tag_ids without managing its tags.
+func testAccScalrEnvironmentTagUnmanagedConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "unmanaged" {
+  name        = "test-env-unmanaged-%d"
+  account_id  = "%s"
+  manage_tags = false
+  tag_ids     = [scalr_tag.foo.id]
+}
+`, rInt, defaultAccount)
+}
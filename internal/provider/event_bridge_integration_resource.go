@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+	"github.com/scalr/go-scalr/v2/scalr/value"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+const (
+	eventBridgeIntegrationActivationTimeout      = 5 * time.Minute
+	eventBridgeIntegrationActivationPollInterval = 2 * time.Second
+)
+
+// Compile-time interface checks
+var (
+	_ resource.Resource                = &eventBridgeIntegrationResource{}
+	_ resource.ResourceWithConfigure   = &eventBridgeIntegrationResource{}
+	_ resource.ResourceWithImportState = &eventBridgeIntegrationResource{}
+)
+
+func newEventBridgeIntegrationResource() resource.Resource {
+	return &eventBridgeIntegrationResource{}
+}
+
+// eventBridgeIntegrationResource defines the resource implementation.
+type eventBridgeIntegrationResource struct {
+	framework.ResourceWithScalrClient
+}
+
+// eventBridgeIntegrationResourceModel describes the resource data model.
+type eventBridgeIntegrationResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	AWSAccountID    types.String `tfsdk:"aws_account_id"`
+	Region          types.String `tfsdk:"region"`
+	EventSourceName types.String `tfsdk:"event_source_name"`
+	EventSourceARN  types.String `tfsdk:"event_source_arn"`
+	Status          types.String `tfsdk:"status"`
+}
+
+// eventBridgeIntegrationAPIErrorPaths maps the API error sources of the event bridge integration to its attributes.
+var eventBridgeIntegrationAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/name":           path.Root("name"),
+	"/data/attributes/aws-account-id": path.Root("aws_account_id"),
+	"/data/attributes/region":         path.Root("region"),
+}
+
+func (r *eventBridgeIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_bridge_integration"
+}
+
+func (r *eventBridgeIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the state of EventBridge integrations in Scalr. Create, update and destroy." +
+			" The integration creates a partner event source in the AWS account, which receives the events of the whole Scalr account.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the EventBridge integration.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"aws_account_id": schema.StringAttribute{
+				MarkdownDescription: "AWS account ID.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "AWS region.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"event_source_name": schema.StringAttribute{
+				MarkdownDescription: "Event source name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_source_arn": schema.StringAttribute{
+				MarkdownDescription: "ARN of the event source.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the integration: `active`, `disabled` or `failed`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *eventBridgeIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan eventBridgeIntegrationResourceModel
+
+	// Read plan data
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := schemas.AWSEventBridgeIntegrationRequest{
+		Attributes: schemas.AWSEventBridgeIntegrationAttributesRequest{
+			Name:         value.Set(plan.Name.ValueString()),
+			AwsAccountId: value.Set(plan.AWSAccountID.ValueString()),
+			Region:       value.Set(plan.Region.ValueString()),
+		},
+	}
+	integration, err := r.ClientV2.AWSEventBridgeIntegration.CreateAwsEventBridgeIntegration(ctx, &opts)
+	if err != nil {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error creating EventBridge integration", err,
+			framework.WithAttributePaths(eventBridgeIntegrationAPIErrorPaths),
+		)...)
+		return
+	}
+
+	// The event source is created in AWS asynchronously, and its ARN is only known once it is done.
+	integration, err = r.waitForEventBridgeIntegration(ctx, integration.ID)
+	if err != nil {
+		// Keep the integration in the state, so that it is tainted and replaced on the next apply.
+		plan.Id = types.StringValue(integration.ID)
+		plan.EventSourceName = types.StringNull()
+		plan.EventSourceARN = types.StringNull()
+		plan.Status = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		resp.Diagnostics.AddError("Error activating EventBridge integration", err.Error())
+		return
+	}
+
+	eventBridgeIntegrationToModel(integration, &plan)
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *eventBridgeIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state eventBridgeIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed resource state from API
+	integration, err := r.ClientV2.AWSEventBridgeIntegration.GetAwsEventBridgeIntegration(ctx, state.Id.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving EventBridge integration", err.Error())
+		return
+	}
+
+	// Overwrite attributes with refreshed values
+	eventBridgeIntegrationToModel(integration, &state)
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *eventBridgeIntegrationResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// Not updatable - any attribute change forces recreate.
+}
+
+func (r *eventBridgeIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state eventBridgeIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.ClientV2.AWSEventBridgeIntegration.DeleteAwsEventBridgeIntegration(ctx, state.Id.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting EventBridge integration", err)...)
+		return
+	}
+}
+
+func (r *eventBridgeIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// waitForEventBridgeIntegration waits until the partner event source is created in AWS.
+// On failure, the last known integration is returned along with the error.
+func (r *eventBridgeIntegrationResource) waitForEventBridgeIntegration(
+	ctx context.Context,
+	id string,
+) (*schemas.AWSEventBridgeIntegration, error) {
+	last := &schemas.AWSEventBridgeIntegration{ID: id}
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{string(schemas.AWSEventBridgeIntegrationStatusPending)},
+		Target: []string{
+			string(schemas.AWSEventBridgeIntegrationStatusActive),
+			string(schemas.AWSEventBridgeIntegrationStatusDisabled),
+		},
+		Refresh: func() (interface{}, string, error) {
+			integration, err := r.ClientV2.AWSEventBridgeIntegration.GetAwsEventBridgeIntegration(ctx, id)
+			if err != nil {
+				return nil, "", err
+			}
+			last = integration
+			if integration.Attributes.Status == schemas.AWSEventBridgeIntegrationStatusFailed {
+				msg := "unknown error"
+				if integration.Attributes.ErrMessage != nil {
+					msg = *integration.Attributes.ErrMessage
+				}
+				return nil, "", fmt.Errorf("EventBridge integration failed: %s", msg)
+			}
+			return integration, string(integration.Attributes.Status), nil
+		},
+		Timeout:    eventBridgeIntegrationActivationTimeout,
+		MinTimeout: eventBridgeIntegrationActivationPollInterval,
+	}
+
+	integration, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return last, err
+	}
+	return integration.(*schemas.AWSEventBridgeIntegration), nil
+}
+
+func eventBridgeIntegrationToModel(integration *schemas.AWSEventBridgeIntegration, m *eventBridgeIntegrationResourceModel) {
+	m.Id = types.StringValue(integration.ID)
+	m.Name = types.StringValue(integration.Attributes.Name)
+	m.AWSAccountID = types.StringValue(integration.Attributes.AwsAccountId)
+	m.Region = types.StringValue(integration.Attributes.Region)
+	m.EventSourceName = types.StringValue(integration.Attributes.EventSource)
+	m.EventSourceARN = types.StringValue(integration.Attributes.EventSourceArn)
+	m.Status = types.StringValue(string(integration.Attributes.Status))
+}
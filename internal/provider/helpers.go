@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"strings"
 
 	"github.com/scalr/go-scalr"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
 
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 	"github.com/scalr/terraform-provider-scalr/internal/framework/defaults"
 )
 
@@ -22,7 +25,20 @@ type GetEnvironmentByNameOptions struct {
 	Include *string
 }
 
-func GetEnvironmentByName(ctx context.Context, options GetEnvironmentByNameOptions, scalrClient *scalr.Client) (*scalr.Environment, error) {
+func GetEnvironmentByName(
+	ctx context.Context, options GetEnvironmentByNameOptions, scalrClient *scalr.Client, cache *framework.LookupCache,
+) (*scalr.Environment, error) {
+	key := fmt.Sprintf("name=%s&account=%s&include=%s",
+		derefString(options.Name), derefString(options.Account), derefString(options.Include))
+
+	return framework.CachedLookup(ctx, cache, framework.LookupEnvironments, key,
+		func() (*scalr.Environment, error) {
+			return getEnvironmentByName(ctx, options, scalrClient)
+		},
+	)
+}
+
+func getEnvironmentByName(ctx context.Context, options GetEnvironmentByNameOptions, scalrClient *scalr.Client) (*scalr.Environment, error) {
 	listOptions := scalr.EnvironmentListOptions{
 		Include: options.Include,
 		Filter: &scalr.EnvironmentFilter{
@@ -50,7 +66,19 @@ type GetWebhookByNameOptions struct {
 	Account *string
 }
 
-func GetWebhookByName(ctx context.Context, options GetWebhookByNameOptions, scalrClient *scalr.Client) (*scalr.WebhookIntegration, error) {
+func GetWebhookByName(
+	ctx context.Context, options GetWebhookByNameOptions, scalrClient *scalr.Client, cache *framework.LookupCache,
+) (*scalr.WebhookIntegration, error) {
+	key := fmt.Sprintf("name=%s&account=%s", derefString(options.Name), derefString(options.Account))
+
+	return framework.CachedLookup(ctx, cache, framework.LookupWebhookIntegrations, key,
+		func() (*scalr.WebhookIntegration, error) {
+			return getWebhookByName(ctx, options, scalrClient)
+		},
+	)
+}
+
+func getWebhookByName(ctx context.Context, options GetWebhookByNameOptions, scalrClient *scalr.Client) (*scalr.WebhookIntegration, error) {
 	listOptions := scalr.WebhookIntegrationListOptions{
 		Query:   options.Name,
 		Account: options.Account,
@@ -139,6 +167,14 @@ func ptr[T any](v T) *T {
 	return &v
 }
 
+// derefString returns the value the pointer points to, or an empty string for nil pointer.
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // diff returns the added and removed elements between two slices.
 func diff[T comparable](old, new []T) (added, removed []T) {
 	newSet := make(map[T]struct{}, len(new))
@@ -183,3 +219,18 @@ func setsEqual[T comparable](a, b []T) bool {
 
 	return true
 }
+
+// containsFold reports whether substr is within s, ignoring the case.
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// environmentsContain reports whether the environment is in the list of related environments.
+func environmentsContain(environments []*schemas.Environment, environmentID string) bool {
+	for _, env := range environments {
+		if env != nil && env.ID == environmentID {
+			return true
+		}
+	}
+	return false
+}
@@ -165,7 +165,7 @@ func (r *hookResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	hook, err := r.Client.Hooks.Create(ctx, options)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating hook", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error creating hook", err)...)
 		return
 	}
 
@@ -325,7 +325,7 @@ func (r *hookResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	hook, err := r.Client.Hooks.Update(ctx, plan.Id.ValueString(), options)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating hook", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error updating hook", err)...)
 		return
 	}
 
@@ -379,7 +379,7 @@ func (r *hookResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	err := r.Client.Hooks.Delete(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, scalr.ErrResourceNotFound) {
-		resp.Diagnostics.AddError("Error deleting hook", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting hook", err)...)
 	}
 }
 
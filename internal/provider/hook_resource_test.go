@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccScalrHook_basic(t *testing.T) {
@@ -118,7 +120,7 @@ func testAccCheckScalrHookExists(
 			return fmt.Errorf("no hook ID is set")
 		}
 
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 		h, err := scalrClient.Hooks.Read(ctx, rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error reading hook %s: %v", rs.Primary.ID, err)
@@ -130,7 +132,7 @@ func testAccCheckScalrHookExists(
 }
 
 func testAccCheckScalrHookDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_hook" {
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrHooksDataSource_basic(t *testing.T) {
+	hookName := acctest.RandomWithPrefix("test-hooks")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			t.Skip("Works with personal token but does not work with github action token.")
+			testVcsAccGithubTokenPreCheck(t)
+		},
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrHooksDataSourceConfig(hookName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_hooks.test", "id"),
+					resource.TestCheckResourceAttr("data.scalr_hooks.test", "ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("data.scalr_hooks.test", "ids.*", "scalr_hook.foo", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.scalr_hooks.test", "ids.*", "scalr_hook.bar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScalrHooksDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource scalr_vcs_provider test {
+  name       = "%[1]s-vcs"
+  vcs_type   = "github"
+  token      = "%[2]s"
+}
+
+resource scalr_hook foo {
+  name            = "%[1]s-foo"
+  interpreter     = "bash"
+  scriptfile_path = "script.sh"
+  vcs_provider_id = scalr_vcs_provider.test.id
+
+  vcs_repo {
+    identifier = "scalr/terraform-provider-scalr"
+    branch     = "main"
+  }
+}
+
+resource scalr_hook bar {
+  name            = "%[1]s-bar"
+  interpreter     = "bash"
+  scriptfile_path = "script.sh"
+  vcs_provider_id = scalr_vcs_provider.test.id
+
+  vcs_repo {
+    identifier = "scalr/terraform-provider-scalr"
+    branch     = "main"
+  }
+}
+
+data scalr_hooks test {
+  name       = "like:%[1]s-"
+  depends_on = [scalr_hook.foo, scalr_hook.bar]
+}`, name, githubToken)
+}
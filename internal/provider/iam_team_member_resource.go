@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+	"github.com/scalr/go-scalr/v2/scalr/value"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// The team API only replaces the whole member list,
+// so concurrent membership changes must not overwrite each other.
+var iamTeamMemberMutex sync.Mutex
+
+// Compile-time interface checks
+var (
+	_ resource.Resource                = &iamTeamMemberResource{}
+	_ resource.ResourceWithConfigure   = &iamTeamMemberResource{}
+	_ resource.ResourceWithImportState = &iamTeamMemberResource{}
+)
+
+func newIamTeamMemberResource() resource.Resource {
+	return &iamTeamMemberResource{}
+}
+
+// iamTeamMemberResource defines the resource implementation.
+type iamTeamMemberResource struct {
+	framework.ResourceWithScalrClient
+}
+
+// iamTeamMemberResourceModel describes the resource data model.
+type iamTeamMemberResourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	TeamID types.String `tfsdk:"team_id"`
+	UserID types.String `tfsdk:"user_id"`
+}
+
+// iamTeamMemberAPIErrorPaths maps the API error sources of the IAM team member to its attributes.
+var iamTeamMemberAPIErrorPaths = framework.APIErrorPaths{
+	"/data/relationships/users": path.Root("user_id"),
+}
+
+func (r *iamTeamMemberResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_iam_team_member"
+}
+
+func (r *iamTeamMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a user to a Scalr IAM team. Unlike `scalr_iam_team.users`, this resource" +
+			" is non-authoritative: it manages a single membership and leaves the other members of the team untouched." +
+			"\n\n~> **Note:** Do not configure `users` on the `scalr_iam_team` resource together with this resource" +
+			" for the same team, otherwise they will conflict over the team members." +
+			" Team membership cannot be managed when the account uses an external identity provider.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource, in the format `<team_id>/<user_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team, in the format `team-<RANDOM STRING>`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user, in the format `user-<RANDOM STRING>`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *iamTeamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan iamTeamMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := plan.TeamID.ValueString()
+	userID := plan.UserID.ValueString()
+
+	iamTeamMemberMutex.Lock()
+	defer iamTeamMemberMutex.Unlock()
+
+	members, err := r.getTeamMembers(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving team", err.Error())
+		return
+	}
+
+	if !slices.Contains(members, userID) {
+		members, err = r.updateTeamMembers(ctx, teamID, append(members, userID))
+		if err != nil {
+			resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+				"Error adding user to team", err,
+				framework.WithRequiredPermission("iam:teams:update"),
+				framework.WithAttributePaths(iamTeamMemberAPIErrorPaths),
+			)...)
+			return
+		}
+		if !slices.Contains(members, userID) {
+			resp.Diagnostics.AddError(
+				"Error adding user to team",
+				fmt.Sprintf(
+					"User %s was not added to team %s. The team membership is likely managed by an external identity provider.",
+					userID, teamID,
+				),
+			)
+			return
+		}
+	}
+
+	plan.Id = types.StringValue(teamID + "/" + userID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *iamTeamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state iamTeamMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.getTeamMembers(ctx, state.TeamID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving team", err.Error())
+		return
+	}
+
+	if !slices.Contains(members, state.UserID.ValueString()) {
+		// The user was removed from the team outside of Terraform.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *iamTeamMemberResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// Not updatable - any attribute change forces recreate.
+}
+
+func (r *iamTeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state iamTeamMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := state.TeamID.ValueString()
+	userID := state.UserID.ValueString()
+
+	iamTeamMemberMutex.Lock()
+	defer iamTeamMemberMutex.Unlock()
+
+	members, err := r.getTeamMembers(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving team", err.Error())
+		return
+	}
+
+	idx := slices.Index(members, userID)
+	if idx < 0 {
+		return
+	}
+
+	_, err = r.updateTeamMembers(ctx, teamID, slices.Delete(members, idx, idx+1))
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error removing user from team", err,
+			framework.WithRequiredPermission("iam:teams:update"),
+		)...)
+		return
+	}
+}
+
+func (r *iamTeamMemberResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected import ID in the format <team_id>/<user_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+}
+
+// updateTeamMembers replaces the member list of the team and returns the resulting one.
+func (r *iamTeamMemberResource) updateTeamMembers(ctx context.Context, teamID string, members []string) ([]string, error) {
+	users := make([]schemas.User, len(members))
+	for i, id := range members {
+		users[i] = schemas.User{ID: id}
+	}
+
+	t, err := r.ClientV2.Team.UpdateTeam(
+		ctx, teamID, &schemas.TeamRequest{
+			Relationships: schemas.TeamRelationshipsRequest{Users: value.Set(users)},
+		}, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return teamMemberIDs(t), nil
+}
+
+// getTeamMembers returns the IDs of the team members.
+func (r *iamTeamMemberResource) getTeamMembers(ctx context.Context, teamID string) ([]string, error) {
+	t, err := r.ClientV2.Team.GetTeam(ctx, teamID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return teamMemberIDs(t), nil
+}
+
+func teamMemberIDs(t *schemas.Team) []string {
+	ids := make([]string, 0, len(t.Relationships.Users))
+	for _, u := range t.Relationships.Users {
+		if u != nil {
+			ids = append(ids, u.ID)
+		}
+	}
+	return ids
+}
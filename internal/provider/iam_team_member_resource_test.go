@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccScalrIamTeamMember_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-team")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrIamTeamMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrIamTeamMemberConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrIamTeamMemberExists("scalr_iam_team_member.test"),
+					resource.TestCheckResourceAttrPair(
+						"scalr_iam_team_member.test", "team_id",
+						"scalr_iam_team.test", "id",
+					),
+					resource.TestCheckResourceAttr("scalr_iam_team_member.test", "user_id", testUser),
+				),
+			},
+			{
+				// The team doesn't manage its members, so the added ones cause no drift.
+				Config: testAccScalrIamTeamMemberConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_iam_team.test", "users.#", "1"),
+					resource.TestCheckResourceAttr("scalr_iam_team.test", "users.0", testUser),
+				),
+			},
+		},
+	})
+}
+
+func TestAccScalrIamTeamMember_import(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-team")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrIamTeamMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrIamTeamMemberConfig(name),
+			},
+			{
+				ResourceName:      "scalr_iam_team_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScalrIamTeamMemberExists(resID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		scalrClient := createScalrClientV2()
+
+		rs, ok := s.RootModule().Resources[resID]
+		if !ok {
+			return fmt.Errorf("not found: %s", resID)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no instance ID is set")
+		}
+
+		parts := strings.SplitN(rs.Primary.ID, "/", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid ID format: %s", rs.Primary.ID)
+		}
+		teamID, userID := parts[0], parts[1]
+
+		team, err := scalrClient.Team.GetTeam(ctx, teamID, nil)
+		if err != nil {
+			return fmt.Errorf("error retrieving team %s: %w", teamID, err)
+		}
+
+		if !slices.Contains(teamMemberIDs(team), userID) {
+			return fmt.Errorf("user %s is not a member of team %s", userID, teamID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckScalrIamTeamMemberDestroy(s *terraform.State) error {
+	scalrClient := createScalrClientV2()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "scalr_iam_team_member" {
+			continue
+		}
+		if rs.Primary.ID == "" {
+			continue
+		}
+
+		parts := strings.SplitN(rs.Primary.ID, "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		teamID, userID := parts[0], parts[1]
+
+		team, err := scalrClient.Team.GetTeam(ctx, teamID, nil)
+		if err != nil {
+			// Team may already be deleted — treat as success.
+			continue
+		}
+
+		if slices.Contains(teamMemberIDs(team), userID) {
+			return fmt.Errorf("user %s is still a member of team %s", userID, teamID)
+		}
+	}
+
+	return nil
+}
+
+func testAccScalrIamTeamMemberConfig(name string) string {
+	return fmt.Sprintf(`
+resource "scalr_iam_team" "test" {
+  name       = "%s"
+  account_id = "%s"
+}
+
+resource "scalr_iam_team_member" "test" {
+  team_id = scalr_iam_team.test.id
+  user_id = "%s"
+}
+`, name, defaultAccount, testUser)
+}
@@ -2,7 +2,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -13,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/scalr/go-scalr/v2/scalr/client"
@@ -34,6 +39,9 @@ var (
 	_ resource.ResourceWithImportState = &iamTeamResource{}
 )
 
+// iamTeamManagedUsersKey is the private state key that holds the users last applied from the `users` attribute.
+const iamTeamManagedUsersKey = "managed_users"
+
 func newIamTeamResource() resource.Resource {
 	return &iamTeamResource{}
 }
@@ -95,6 +103,13 @@ func iamTeamResourceModelFromAPI(ctx context.Context, team *schemas.Team, priorU
 	return model, diags
 }
 
+// iamTeamAPIErrorPaths maps the API error sources of the IAM team to its attributes.
+var iamTeamAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/name":        path.Root("name"),
+	"/data/attributes/description": path.Root("description"),
+	"/data/relationships/users":    path.Root("users"),
+}
+
 func (r *iamTeamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_iam_team"
 }
@@ -144,7 +159,8 @@ func (r *iamTeamResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"users": schema.SetAttribute{
 				MarkdownDescription: "A list of the user identifiers to add to the team." +
 					" This attribute should not be used when the account's identity provider is not of type `scalr`," +
-					" as team membership is managed externally in these cases.",
+					" as team membership is managed externally in these cases." +
+					" If omitted, the team members are not managed, so they can be added with the `scalr_iam_team_member` resource instead.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
@@ -194,7 +210,10 @@ func (r *iamTeamResource) Create(ctx context.Context, req resource.CreateRequest
 		},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating team", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error creating team", err,
+			framework.WithAttributePaths(iamTeamAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -217,6 +236,10 @@ func (r *iamTeamResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+
+	managedUsers, diags := iamTeamManagedUsers(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, iamTeamManagedUsersKey, managedUsers)...)
 }
 
 func (r *iamTeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -293,7 +316,10 @@ func (r *iamTeamResource) Update(ctx context.Context, req resource.UpdateRequest
 		},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating team", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error updating team", err,
+			framework.WithAttributePaths(iamTeamAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -314,6 +340,10 @@ func (r *iamTeamResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+
+	managedUsers, diags := iamTeamManagedUsers(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, iamTeamManagedUsersKey, managedUsers)...)
 }
 
 func (r *iamTeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -325,7 +355,7 @@ func (r *iamTeamResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	err := r.ClientV2.Team.DeleteTeam(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, client.ErrNotFound) {
-		resp.Diagnostics.AddError("Error deleting team", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting team", err)...)
 		return
 	}
 }
@@ -342,6 +372,11 @@ func (r *iamTeamResource) ModifyPlan(
 		return
 	}
 
+	// Warn when the configured `users` would remove the members added outside of this resource.
+	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() && !users.IsNull() && !users.IsUnknown() {
+		resp.Diagnostics.Append(r.warnAboutUnmanagedMembers(ctx, req, users)...)
+	}
+
 	// Terraform Core may replace ignored config paths with prior state before provider planning.
 	// Treat an empty set as not configured to avoid false warnings for omitted, ignored membership.
 	// Explicitly configured empty sets are indistinguishable here and are also not warned about.
@@ -390,6 +425,91 @@ func (r *iamTeamResource) ModifyPlan(
 	}
 }
 
+// warnAboutUnmanagedMembers warns when the configured `users` would remove the team members
+// that were not added by this resource, e.g. the ones managed with `scalr_iam_team_member`.
+func (r *iamTeamResource) warnAboutUnmanagedMembers(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	users types.Set,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	managedBytes, d := req.Private.GetKey(ctx, iamTeamManagedUsersKey)
+	diags.Append(d...)
+	if managedBytes == nil {
+		// The resource was created before the managed users were tracked, nothing to compare with.
+		return diags
+	}
+
+	var managed []string
+	if err := json.Unmarshal(managedBytes, &managed); err != nil {
+		return diags
+	}
+
+	var configured []types.String
+	var current []string
+	diags.Append(users.ElementsAs(ctx, &configured, false)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("users"), &current)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	configuredIDs := make([]string, 0, len(configured))
+	for _, id := range configured {
+		if id.IsUnknown() {
+			return diags
+		}
+		configuredIDs = append(configuredIDs, id.ValueString())
+	}
+
+	var unmanaged []string
+	for _, id := range current {
+		if !slices.Contains(configuredIDs, id) && !slices.Contains(managed, id) {
+			unmanaged = append(unmanaged, id)
+		}
+	}
+
+	if len(unmanaged) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("users"),
+			"Team members are managed outside of this resource.",
+			fmt.Sprintf(
+				"Users %s were added to the team outside of the 'users' attribute, e.g. with the 'scalr_iam_team_member' resource,"+
+					" and will be removed from the team."+
+					"\nDo not use the 'users' attribute together with the 'scalr_iam_team_member' resource for the same team:"+
+					" remove the 'users' attribute from your configuration to manage the members with 'scalr_iam_team_member' only.",
+				strings.Join(unmanaged, ", "),
+			),
+		)
+	}
+
+	return diags
+}
+
+// iamTeamManagedUsers returns the users configured in the `users` attribute, encoded for the private state,
+// or nil when the attribute is omitted.
+func iamTeamManagedUsers(ctx context.Context, config tfsdk.Config) ([]byte, diag.Diagnostics) {
+	var users types.Set
+	diags := config.GetAttribute(ctx, path.Root("users"), &users)
+	if diags.HasError() || users.IsNull() || users.IsUnknown() {
+		return nil, diags
+	}
+
+	var ids []string
+	diags.Append(users.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	managed, err := json.Marshal(ids)
+	if err != nil {
+		diags.AddError("Failed to marshal private metadata", err.Error())
+		return nil, diags
+	}
+
+	return managed, diags
+}
+
 func (r *iamTeamResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/ops/team"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/defaults"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource              = &iamTeamsDataSource{}
+	_ datasource.DataSourceWithConfigure = &iamTeamsDataSource{}
+)
+
+func newIamTeamsDataSource() datasource.DataSource {
+	return &iamTeamsDataSource{}
+}
+
+// iamTeamsDataSource defines the data source implementation.
+type iamTeamsDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// iamTeamsDataSourceModel describes the data source data model.
+type iamTeamsDataSourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	AccountID          types.String `tfsdk:"account_id"`
+	Name               types.String `tfsdk:"name"`
+	IdentityProviderID types.String `tfsdk:"identity_provider_id"`
+	IDs                types.Set    `tfsdk:"ids"`
+	Teams              types.List   `tfsdk:"teams"`
+}
+
+// iamTeamSummaryModel describes a single team in the list.
+type iamTeamSummaryModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	IdentityProviderID types.String `tfsdk:"identity_provider_id"`
+	Users              types.List   `tfsdk:"users"`
+}
+
+var iamTeamSummaryElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                   types.StringType,
+		"name":                 types.StringType,
+		"description":          types.StringType,
+		"identity_provider_id": types.StringType,
+		"users":                types.ListType{ElemType: types.StringType},
+	},
+}
+
+func (d *iamTeamsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_teams"
+}
+
+func (d *iamTeamsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a list of teams in the account, optionally filtered by name and identity provider.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of this data source.",
+				Computed:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Scalr account, in the format `acc-<RANDOM STRING>`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return teams whose name contains this string, case-insensitive.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"identity_provider_id": schema.StringAttribute{
+				MarkdownDescription: "Only return teams of this identity provider, in the format `idp-<RANDOM STRING>`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "The list of team IDs, in the format [`team-xxxxxxxxxxx`, `team-yyyyyyyyy`].",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"teams": schema.ListAttribute{
+				MarkdownDescription: "The list of matching teams, sorted by name. Each team has the `id`, `name`, `description`," +
+					" `identity_provider_id` and the `users` identifiers.",
+				ElementType: iamTeamSummaryElementType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *iamTeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg iamTeamsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var accID string
+	if !cfg.AccountID.IsNull() {
+		accID = cfg.AccountID.ValueString()
+	} else {
+		var diags diag.Diagnostics
+		accID, diags = defaults.GetDefaultScalrAccountID()
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := strings.Builder{} // holds the string to build a unique resource id hash
+	id.WriteString(accID)
+	id.WriteString(cfg.Name.ValueString())
+	id.WriteString(cfg.IdentityProviderID.ValueString())
+
+	opts := &team.GetTeamsOptions{
+		Filter:   map[string]string{"account": accID},
+		PageSize: 100,
+	}
+	if !cfg.IdentityProviderID.IsNull() {
+		opts.Filter["identity-provider"] = cfg.IdentityProviderID.ValueString()
+	}
+
+	teams := make([]iamTeamSummaryModel, 0)
+	for t, err := range d.ClientV2.Team.GetTeamsIter(ctx, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving teams", err.Error())
+			return
+		}
+
+		if !cfg.Name.IsNull() && !containsFold(t.Attributes.Name, cfg.Name.ValueString()) {
+			continue
+		}
+
+		model := iamTeamSummaryModel{
+			Id:                 types.StringValue(t.ID),
+			Name:               types.StringValue(t.Attributes.Name),
+			Description:        types.StringPointerValue(t.Attributes.Description),
+			IdentityProviderID: types.StringNull(),
+		}
+		if t.Relationships.IdentityProvider != nil {
+			model.IdentityProviderID = types.StringValue(t.Relationships.IdentityProvider.ID)
+		}
+		usersValue, diags := types.ListValueFrom(ctx, types.StringType, teamMemberIDs(&t))
+		resp.Diagnostics.Append(diags...)
+		model.Users = usersValue
+
+		teams = append(teams, model)
+	}
+
+	sort.Slice(teams, func(i, j int) bool {
+		return teams[i].Name.ValueString() < teams[j].Name.ValueString()
+	})
+
+	ids := make([]string, len(teams))
+	for i, t := range teams {
+		ids[i] = t.Id.ValueString()
+	}
+
+	cfg.Id = types.StringValue(fmt.Sprintf("%d", framework.HashString(id.String())))
+	cfg.AccountID = types.StringValue(accID)
+
+	idsValue, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	cfg.IDs = idsValue
+
+	teamsValue, diags := types.ListValueFrom(ctx, iamTeamSummaryElementType, teams)
+	resp.Diagnostics.Append(diags...)
+	cfg.Teams = teamsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrIamTeamsDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrIamTeamsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_iam_teams.test", "id"),
+					resource.TestCheckResourceAttr("data.scalr_iam_teams.test", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.scalr_iam_teams.test", "teams.0.name", fmt.Sprintf("test-teams-%d-a", rInt)),
+					resource.TestCheckResourceAttr("data.scalr_iam_teams.test", "teams.0.users.#", "1"),
+					resource.TestCheckResourceAttr("data.scalr_iam_teams.test", "teams.0.users.0", testUser),
+					resource.TestCheckResourceAttr("data.scalr_iam_teams.test", "teams.1.name", fmt.Sprintf("test-teams-%d-b", rInt)),
+					resource.TestCheckResourceAttr("data.scalr_iam_teams.test", "teams.1.description", "Test team"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScalrIamTeamsDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource scalr_iam_team a {
+  name       = "test-teams-%d-a"
+  account_id = "%s"
+  users      = ["%s"]
+}
+
+resource scalr_iam_team b {
+  name        = "test-teams-%[1]d-b"
+  description = "Test team"
+  account_id  = "%[2]s"
+}
+
+data scalr_iam_teams test {
+  name       = "TEST-TEAMS-%[1]d"
+  account_id = "%[2]s"
+  depends_on = [scalr_iam_team.a, scalr_iam_team.b]
+}`, rInt, defaultAccount, testUser)
+}
@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/ops/user"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+	"github.com/scalr/go-scalr/v2/scalr/value"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/defaults"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ resource.Resource                = &iamUserResource{}
+	_ resource.ResourceWithConfigure   = &iamUserResource{}
+	_ resource.ResourceWithImportState = &iamUserResource{}
+)
+
+func newIamUserResource() resource.Resource {
+	return &iamUserResource{}
+}
+
+// iamUserResource defines the resource implementation.
+type iamUserResource struct {
+	framework.ResourceWithScalrClient
+}
+
+// iamUserResourceModel describes the resource data model.
+type iamUserResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Email              types.String `tfsdk:"email"`
+	AccountID          types.String `tfsdk:"account_id"`
+	IdentityProviderID types.String `tfsdk:"identity_provider_id"`
+	SendInvite         types.Bool   `tfsdk:"send_invite"`
+	Status             types.String `tfsdk:"status"`
+	Username           types.String `tfsdk:"username"`
+	FullName           types.String `tfsdk:"full_name"`
+}
+
+func iamUserResourceModelFromAPI(
+	u *schemas.User,
+	accountUser *schemas.AccountUser,
+	existing *iamUserResourceModel,
+) *iamUserResourceModel {
+	model := &iamUserResourceModel{
+		Id:                 types.StringValue(u.ID),
+		Email:              types.StringValue(u.Attributes.Email),
+		AccountID:          existing.AccountID,
+		IdentityProviderID: types.StringNull(),
+		SendInvite:         existing.SendInvite,
+		Status:             types.StringValue(string(accountUser.Attributes.Status)),
+		Username:           types.StringValue(u.Attributes.Username),
+		FullName:           types.StringPointerValue(u.Attributes.FullName),
+	}
+
+	if accountUser.Relationships.Account != nil {
+		model.AccountID = types.StringValue(accountUser.Relationships.Account.ID)
+	}
+
+	// The user may belong to several identity providers, only the managed one is tracked.
+	if !existing.IdentityProviderID.IsNull() &&
+		slices.Contains(userIdentityProviderIDs(u), existing.IdentityProviderID.ValueString()) {
+		model.IdentityProviderID = existing.IdentityProviderID
+	}
+
+	if model.SendInvite.IsNull() {
+		model.SendInvite = types.BoolValue(true)
+	}
+
+	return model
+}
+
+// iamUserAPIErrorPaths maps the API error sources of the IAM user to its attributes.
+var iamUserAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/email":                 path.Root("email"),
+	"/data/relationships/identity-providers": path.Root("identity_provider_id"),
+}
+
+func (r *iamUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_user"
+}
+
+func (r *iamUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invites a user to a Scalr account by email. If the user with the specified email" +
+			" does not exist yet, it is created and stays in the `Pending` status until the first login." +
+			" On destroy, the user is removed from the account, which revokes its access policies and team memberships" +
+			" in the account.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user, in the format `user-<RANDOM STRING>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "An email of the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the account, in the format `acc-<RANDOM STRING>`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             defaults.AccountIDRequired(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identity_provider_id": schema.StringAttribute{
+				MarkdownDescription: "An identifier of the login identity provider to add the user to," +
+					" in the format `idp-<RANDOM STRING>`. The other identity providers of the user are not affected.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"send_invite": schema.BoolAttribute{
+				MarkdownDescription: "Whether to send the invitation email to the user. Only used when the user is invited." +
+					" Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the user in the account: `Pending` until the invitation is accepted," +
+					" `Active` or `Inactive`.",
+				Computed: true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "A username of the user.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "A full name of the user.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *iamUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan iamUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := plan.AccountID.ValueString()
+
+	opts := schemas.UserInviteRequest{
+		Attributes: schemas.UserInviteAttributesRequest{
+			Email:      value.Set(plan.Email.ValueString()),
+			SendInvite: value.Set(plan.SendInvite.ValueBool()),
+		},
+	}
+
+	accountUser, err := r.ClientV2.User.InviteUserToAccount(ctx, accountID, &opts, nil)
+	if err != nil {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error inviting user", err,
+			framework.WithRequiredPermission("iam:users:create"),
+			framework.WithAttributePaths(iamUserAPIErrorPaths),
+		)...)
+		return
+	}
+	if accountUser.Relationships.User == nil {
+		resp.Diagnostics.AddError("Error inviting user", "The invitation response does not reference the user.")
+		return
+	}
+
+	userID := accountUser.Relationships.User.ID
+
+	// Save the ID early, so the invited user is tracked even if the next steps fail.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), accountID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), plan.Email)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("send_invite"), plan.SendInvite)...)
+
+	if !plan.IdentityProviderID.IsNull() {
+		err = r.updateIdentityProviders(ctx, userID, plan.IdentityProviderID.ValueString(), "")
+		if err != nil {
+			resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+				"Error adding user to identity provider", err,
+				framework.WithRequiredPermission("iam:users:update"),
+				framework.WithAttributePaths(iamUserAPIErrorPaths),
+			)...)
+			return
+		}
+	}
+
+	result, err := r.readUser(ctx, userID, accountID, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *iamUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state iamUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.readUser(ctx, state.Id.ValueString(), state.AccountID.ValueString(), &state)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *iamUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state iamUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.IdentityProviderID.Equal(state.IdentityProviderID) {
+		err := r.updateIdentityProviders(
+			ctx, plan.Id.ValueString(), plan.IdentityProviderID.ValueString(), state.IdentityProviderID.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+				"Error updating user identity providers", err,
+				framework.WithRequiredPermission("iam:users:update"),
+				framework.WithAttributePaths(iamUserAPIErrorPaths),
+			)...)
+			return
+		}
+	}
+
+	result, err := r.readUser(ctx, plan.Id.ValueString(), plan.AccountID.ValueString(), &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *iamUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state iamUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.ClientV2.User.RemoveUserFromAccount(ctx, state.AccountID.ValueString(), state.Id.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error removing user from account", err,
+			framework.WithRequiredPermission("iam:users:delete"),
+		)...)
+		return
+	}
+}
+
+// ImportState handles importing existing resources into Terraform state.
+//
+// The user is imported by its ID in the format '<user_id>' for the current account,
+// or '<account_id>/<user_id>' for the specified one.
+func (r *iamUserResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	accountID, userID, found := strings.Cut(req.ID, "/")
+	if !found {
+		userID = req.ID
+		var diags diag.Diagnostics
+		accountID, diags = defaults.GetDefaultScalrAccountID()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if accountID == "" || userID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected import ID in the format <user_id> or <account_id>/<user_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), accountID)...)
+}
+
+// readUser fetches the user and its membership in the account.
+// It returns client.ErrNotFound when the user no longer exists or was removed from the account.
+func (r *iamUserResource) readUser(
+	ctx context.Context,
+	userID, accountID string,
+	existing *iamUserResourceModel,
+) (*iamUserResourceModel, error) {
+	u, err := r.ClientV2.User.GetUser(ctx, userID, &user.GetUserOptions{Include: []string{"identity-providers"}})
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &user.GetAccountUsersOptions{
+		Filter: map[string]string{"account": accountID, "user": userID},
+	}
+	for accountUser, err := range r.ClientV2.User.GetAccountUsersIter(ctx, opts) {
+		if err != nil {
+			return nil, err
+		}
+		if accountUser.Relationships.User != nil && accountUser.Relationships.User.ID == userID {
+			return iamUserResourceModelFromAPI(u, &accountUser, existing), nil
+		}
+	}
+
+	return nil, client.ErrNotFound
+}
+
+// updateIdentityProviders adds the user to the identity provider `add` and removes it from `remove`,
+// keeping the other identity providers of the user. Either of them can be empty.
+func (r *iamUserResource) updateIdentityProviders(ctx context.Context, userID, add, remove string) error {
+	u, err := r.ClientV2.User.GetUser(ctx, userID, nil)
+	if err != nil {
+		return err
+	}
+
+	idps := make([]schemas.IdentityProvider, 0, len(u.Relationships.IdentityProviders)+1)
+	for _, id := range userIdentityProviderIDs(u) {
+		if id != remove && id != add {
+			idps = append(idps, schemas.IdentityProvider{ID: id})
+		}
+	}
+	if add != "" {
+		idps = append(idps, schemas.IdentityProvider{ID: add})
+	}
+
+	_, err = r.ClientV2.User.UpdateUser(
+		ctx, userID, &schemas.UserRequest{
+			Relationships: schemas.UserRelationshipsRequest{IdentityProviders: value.Set(idps)},
+		}, nil,
+	)
+	return err
+}
+
+func userIdentityProviderIDs(u *schemas.User) []string {
+	ids := make([]string, 0, len(u.Relationships.IdentityProviders))
+	for _, idp := range u.Relationships.IdentityProviders {
+		if idp != nil {
+			ids = append(ids, idp.ID)
+		}
+	}
+	return ids
+}
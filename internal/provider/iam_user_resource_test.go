@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/ops/user"
+)
+
+func TestAccScalrIamUserResource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+	email := fmt.Sprintf("test-user-%d@example.com", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrIamUserResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrIamUserResourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("scalr_iam_user.test", "id", regexp.MustCompile(`^user-`)),
+					resource.TestCheckResourceAttr("scalr_iam_user.test", "email", email),
+					resource.TestCheckResourceAttr("scalr_iam_user.test", "account_id", defaultAccount),
+					resource.TestCheckResourceAttr("scalr_iam_user.test", "send_invite", "false"),
+					resource.TestCheckResourceAttr("scalr_iam_user.test", "status", "Pending"),
+					resource.TestCheckNoResourceAttr("scalr_iam_user.test", "identity_provider_id"),
+					resource.TestCheckResourceAttrSet("scalr_iam_user.test", "username"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"scalr_iam_team.test", "users.*",
+						"scalr_iam_user.test", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccScalrIamUserResource_import(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrIamUserResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrIamUserResourceConfig(rInt),
+			},
+			{
+				ResourceName:            "scalr_iam_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_invite"},
+			},
+		},
+	})
+}
+
+func testAccCheckScalrIamUserResourceDestroy(s *terraform.State) error {
+	scalrClient := createScalrClientV2()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "scalr_iam_user" {
+			continue
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no instance ID is set")
+		}
+
+		opts := &user.GetAccountUsersOptions{
+			Filter: map[string]string{"account": rs.Primary.Attributes["account_id"], "user": rs.Primary.ID},
+		}
+		accountUsers, err := scalrClient.User.GetAccountUsers(ctx, opts)
+		if err != nil {
+			if errors.Is(err, client.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		if len(accountUsers) > 0 {
+			return fmt.Errorf("user %s still has access to account %s", rs.Primary.ID, rs.Primary.Attributes["account_id"])
+		}
+	}
+
+	return nil
+}
+
+func testAccScalrIamUserResourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "scalr_iam_user" "test" {
+  email       = "test-user-%d@example.com"
+  account_id  = "%s"
+  send_invite = false
+}
+
+resource "scalr_iam_team" "test" {
+  name       = "test-team-%[1]d"
+  account_id = "%[2]s"
+  users      = [scalr_iam_user.test.id]
+}
+`, rInt, defaultAccount)
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/ops/user"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/defaults"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource              = &iamUsersDataSource{}
+	_ datasource.DataSourceWithConfigure = &iamUsersDataSource{}
+)
+
+func newIamUsersDataSource() datasource.DataSource {
+	return &iamUsersDataSource{}
+}
+
+// iamUsersDataSource defines the data source implementation.
+type iamUsersDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// iamUsersDataSourceModel describes the data source data model.
+type iamUsersDataSourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	AccountID          types.String `tfsdk:"account_id"`
+	Email              types.String `tfsdk:"email"`
+	IdentityProviderID types.String `tfsdk:"identity_provider_id"`
+	Status             types.String `tfsdk:"status"`
+	IDs                types.Set    `tfsdk:"ids"`
+	Users              types.List   `tfsdk:"users"`
+}
+
+// iamUserSummaryModel describes a single user in the list.
+type iamUserSummaryModel struct {
+	Id       types.String `tfsdk:"id"`
+	Email    types.String `tfsdk:"email"`
+	Username types.String `tfsdk:"username"`
+	FullName types.String `tfsdk:"full_name"`
+	Status   types.String `tfsdk:"status"`
+}
+
+var iamUserSummaryElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":        types.StringType,
+		"email":     types.StringType,
+		"username":  types.StringType,
+		"full_name": types.StringType,
+		"status":    types.StringType,
+	},
+}
+
+func (d *iamUsersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_users"
+}
+
+func (d *iamUsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a list of users that have access to the account, optionally filtered by email, identity provider and status.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of this data source.",
+				Computed:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Scalr account, in the format `acc-<RANDOM STRING>`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Only return users whose email contains this string, case-insensitive.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"identity_provider_id": schema.StringAttribute{
+				MarkdownDescription: "Only return users of this identity provider, in the format `idp-<RANDOM STRING>`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return users in this status in the account: `Active`, `Inactive` or `Pending`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(schemas.AccountUserStatusActive),
+						string(schemas.AccountUserStatusInactive),
+						string(schemas.AccountUserStatusPending),
+					),
+				},
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "The list of user IDs, in the format [`user-xxxxxxxxxxx`, `user-yyyyyyyyy`].",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"users": schema.ListAttribute{
+				MarkdownDescription: "The list of matching users, sorted by email. Each user has the `id`, `email`, `username`, `full_name`" +
+					" and `status` in the account.",
+				ElementType: iamUserSummaryElementType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *iamUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg iamUsersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var accID string
+	if !cfg.AccountID.IsNull() {
+		accID = cfg.AccountID.ValueString()
+	} else {
+		var diags diag.Diagnostics
+		accID, diags = defaults.GetDefaultScalrAccountID()
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := strings.Builder{} // holds the string to build a unique resource id hash
+	id.WriteString(accID)
+	id.WriteString(cfg.Email.ValueString())
+	id.WriteString(cfg.IdentityProviderID.ValueString())
+	id.WriteString(cfg.Status.ValueString())
+
+	// The account users don't reference identity providers, so collect the users of the provider first.
+	var idpUsers map[string]struct{}
+	if !cfg.IdentityProviderID.IsNull() {
+		idpUsers = make(map[string]struct{})
+		opts := &user.GetUsersOptions{
+			Filter:   map[string]string{"identity-provider": cfg.IdentityProviderID.ValueString()},
+			PageSize: 100,
+		}
+		for u, err := range d.ClientV2.User.GetUsersIter(ctx, opts) {
+			if err != nil {
+				resp.Diagnostics.AddError("Error retrieving users", err.Error())
+				return
+			}
+			idpUsers[u.ID] = struct{}{}
+		}
+	}
+
+	opts := &user.GetAccountUsersOptions{
+		Filter:   map[string]string{"account": accID},
+		Include:  []string{"user"},
+		PageSize: 100,
+	}
+	if !cfg.Email.IsNull() {
+		opts.Query = cfg.Email.ValueString()
+	}
+	if !cfg.Status.IsNull() {
+		opts.Filter["status"] = cfg.Status.ValueString()
+	}
+
+	users := make([]iamUserSummaryModel, 0)
+	for accountUser, err := range d.ClientV2.User.GetAccountUsersIter(ctx, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving account users", err.Error())
+			return
+		}
+
+		u := accountUser.Relationships.User
+		if u == nil {
+			continue
+		}
+		// The query may match other fields of the user than the email, and the status is filtered by the API as well,
+		// so these checks only guard the result.
+		if !cfg.Email.IsNull() && !containsFold(u.Attributes.Email, cfg.Email.ValueString()) {
+			continue
+		}
+		if !cfg.Status.IsNull() && string(accountUser.Attributes.Status) != cfg.Status.ValueString() {
+			continue
+		}
+		if idpUsers != nil {
+			if _, ok := idpUsers[u.ID]; !ok {
+				continue
+			}
+		}
+
+		users = append(users, iamUserSummaryModel{
+			Id:       types.StringValue(u.ID),
+			Email:    types.StringValue(u.Attributes.Email),
+			Username: types.StringValue(u.Attributes.Username),
+			FullName: types.StringPointerValue(u.Attributes.FullName),
+			Status:   types.StringValue(string(accountUser.Attributes.Status)),
+		})
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Email.ValueString() < users[j].Email.ValueString()
+	})
+
+	ids := make([]string, len(users))
+	for i, u := range users {
+		ids[i] = u.Id.ValueString()
+	}
+
+	cfg.Id = types.StringValue(fmt.Sprintf("%d", framework.HashString(id.String())))
+	cfg.AccountID = types.StringValue(accID)
+
+	idsValue, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	cfg.IDs = idsValue
+
+	usersValue, diags := types.ListValueFrom(ctx, iamUserSummaryElementType, users)
+	resp.Diagnostics.Append(diags...)
+	cfg.Users = usersValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrIamUsersDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      `data scalr_iam_users test { status = "Unknown" }`,
+				ExpectError: regexp.MustCompile("Attribute status value must be one of"),
+				PlanOnly:    true,
+			},
+			{
+				Config: fmt.Sprintf(`
+data scalr_iam_users all {
+  account_id = "%s"
+}
+
+data scalr_iam_users test {
+  account_id = "%[1]s"
+  email      = "%s"
+  status     = "Active"
+}`, defaultAccount, testUserEmail),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_iam_users.all", "id"),
+					resource.TestCheckTypeSetElemAttr("data.scalr_iam_users.all", "ids.*", testUser),
+					resource.TestCheckResourceAttr("data.scalr_iam_users.test", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.scalr_iam_users.test", "users.0.id", testUser),
+					resource.TestCheckResourceAttr("data.scalr_iam_users.test", "users.0.email", testUserEmail),
+					resource.TestCheckResourceAttr("data.scalr_iam_users.test", "users.0.status", "Active"),
+				),
+			},
+		},
+	})
+}
@@ -132,7 +132,7 @@ func (r *integrationInfracostResource) Create(ctx context.Context, req resource.
 
 	integrationInfracost, err := r.Client.InfracostIntegrations.Create(ctx, opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Infracost integration", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error creating Infracost integration", err)...)
 		return
 	}
 
@@ -251,7 +251,7 @@ func (r *integrationInfracostResource) Update(ctx context.Context, req resource.
 	// Update existing resource
 	integrationInfracost, err := r.Client.InfracostIntegrations.Update(ctx, plan.Id.ValueString(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Infracost integration", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error updating Infracost integration", err)...)
 		return
 	}
 
@@ -290,7 +290,7 @@ func (r *integrationInfracostResource) Delete(ctx context.Context, req resource.
 
 	err := r.Client.InfracostIntegrations.Delete(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, scalr.ErrResourceNotFound) {
-		resp.Diagnostics.AddError("Error deleting Infracost integration", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting Infracost integration", err)...)
 		return
 	}
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/scalr/go-scalr"
 	"os"
 	"testing"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestIntegrationInfracostResource_Create(t *testing.T) {
@@ -110,7 +112,7 @@ resource "scalr_integration_infracost" "test" {
 }
 
 func testAccCheckInfracostIntegrationDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_integration_infracost" {
@@ -137,7 +139,7 @@ func testAccCheckInfracostIntegrationIsShared(resourceName string, expectedIsSha
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 		readKey, err := scalrClient.InfracostIntegrations.Read(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Error reading Infracost Integration: %s", err)
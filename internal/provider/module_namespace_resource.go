@@ -135,7 +135,7 @@ func (r *moduleNamespaceResource) Create(ctx context.Context, req resource.Creat
 
 	namespace, err := r.Client.ModuleNamespaces.Create(ctx, opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating module namespace", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error creating module namespace", err)...)
 		return
 	}
 
@@ -266,7 +266,7 @@ func (r *moduleNamespaceResource) Update(ctx context.Context, req resource.Updat
 
 	namespace, err := r.Client.ModuleNamespaces.Update(ctx, plan.ID.ValueString(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating module namespace", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error updating module namespace", err)...)
 		return
 	}
 
@@ -316,7 +316,7 @@ func (r *moduleNamespaceResource) Delete(ctx context.Context, req resource.Delet
 
 	err := r.Client.ModuleNamespaces.Delete(ctx, state.ID.ValueString())
 	if err != nil && !errors.Is(err, scalr.ErrResourceNotFound) {
-		resp.Diagnostics.AddError("Error deleting module namespace", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting module namespace", err)...)
 		return
 	}
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccScalrModuleNamespace_basic(t *testing.T) {
@@ -150,7 +152,7 @@ resource "scalr_module_namespace" "test" {
 
 func testAccCheckScalrModuleNamespaceExists(resId string, namespace *scalr.ModuleNamespace) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		rs, ok := s.RootModule().Resources[resId]
 		if !ok {
@@ -173,7 +175,7 @@ func testAccCheckScalrModuleNamespaceExists(resId string, namespace *scalr.Modul
 }
 
 func testAccCheckScalrModuleNamespaceDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_module_namespace" {
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+var (
+	_ datasource.DataSource                     = &workspaceOutputDataSource{}
+	_ datasource.DataSourceWithConfigure        = &workspaceOutputDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &workspaceOutputDataSource{}
+)
+
+func newOutputDataSource() datasource.DataSource {
+	return &workspaceOutputDataSource{}
+}
+
+// workspaceOutputDataSource defines the data source implementation.
+type workspaceOutputDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+type outputModel struct {
+	ID                types.String  `tfsdk:"id"`
+	WorkspaceID       types.String  `tfsdk:"workspace_id"`
+	Environment       types.String  `tfsdk:"environment"`
+	Workspace         types.String  `tfsdk:"workspace"`
+	Name              types.String  `tfsdk:"name"`
+	Value             types.Dynamic `tfsdk:"value"`
+	NonSensitiveValue types.Dynamic `tfsdk:"nonsensitive_value"`
+	Sensitive         types.Bool    `tfsdk:"sensitive"`
+	Type              types.String  `tfsdk:"type"`
+}
+
+func (d *workspaceOutputDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_output"
+}
+
+func (d *workspaceOutputDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a single output of a Scalr workspace. Fails if the workspace has no such output." +
+			" The workspace is looked up either by `workspace_id`, or by the `environment` and `workspace` names.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the output, in the format `<workspace_id>/<name>`.",
+				Computed:            true,
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace, in the format `ws-<RANDOM STRING>`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "The name of the environment the workspace belongs to.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "The name of the workspace.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the output.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"value": schema.DynamicAttribute{
+				MarkdownDescription: "The value of the output.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"nonsensitive_value": schema.DynamicAttribute{
+				MarkdownDescription: "The value of the output if it is not sensitive, otherwise null.",
+				Computed:            true,
+			},
+			"sensitive": schema.BoolAttribute{
+				MarkdownDescription: "Whether the output is sensitive.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the value as a Terraform type constraint, e.g. `string` or `list(number)`." +
+					" The type is derived from the value, so a map is reported as an `object`.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *workspaceOutputDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return workspaceLookupConfigValidators()
+}
+
+func (d *workspaceOutputDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg outputModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ws, diags := lookupOutputsWorkspace(ctx, d.ClientV2, cfg.WorkspaceID, cfg.Environment, cfg.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg.WorkspaceID = types.StringValue(ws.ID)
+	cfg.Workspace = types.StringValue(ws.Attributes.Name)
+	if ws.Relationships.Environment != nil && ws.Relationships.Environment.Attributes.Name != "" {
+		cfg.Environment = types.StringValue(ws.Relationships.Environment.Attributes.Name)
+	}
+
+	outputs, err := getWorkspaceOutputs(ctx, d.ClientV2, ws.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace outputs", err.Error())
+		return
+	}
+
+	name := cfg.Name.ValueString()
+	var output *workspaceOutput
+	for i := range outputs {
+		if outputs[i].Name == name {
+			output = &outputs[i]
+			break
+		}
+	}
+	if output == nil {
+		resp.Diagnostics.AddError(
+			"Output not found",
+			fmt.Sprintf("Workspace %s has no output %q.", ws.ID, name),
+		)
+		return
+	}
+
+	attrType, attrValue, diags := jsonRawToAttrValue(output.Value)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg.ID = types.StringValue(ws.ID + "/" + name)
+	cfg.Value = types.DynamicValue(attrValue)
+	cfg.NonSensitiveValue = types.DynamicNull()
+	if !output.Sensitive {
+		cfg.NonSensitiveValue = types.DynamicValue(attrValue)
+	}
+	cfg.Sensitive = types.BoolValue(output.Sensitive)
+	cfg.Type = types.StringValue(typeConstraint(attrType))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// typeConstraint formats the type in the Terraform type constraint syntax.
+func typeConstraint(t attr.Type) string {
+	switch tt := t.(type) {
+	case basetypes.StringType:
+		return "string"
+	case basetypes.NumberType:
+		return "number"
+	case basetypes.BoolType:
+		return "bool"
+	case types.ListType:
+		return fmt.Sprintf("list(%s)", typeConstraint(tt.ElemType))
+	case types.SetType:
+		return fmt.Sprintf("set(%s)", typeConstraint(tt.ElemType))
+	case types.MapType:
+		return fmt.Sprintf("map(%s)", typeConstraint(tt.ElemType))
+	case types.TupleType:
+		elems := make([]string, len(tt.ElemTypes))
+		for i, et := range tt.ElemTypes {
+			elems[i] = typeConstraint(et)
+		}
+		return fmt.Sprintf("tuple([%s])", strings.Join(elems, ", "))
+	case types.ObjectType:
+		keys := make([]string, 0, len(tt.AttrTypes))
+		for k := range tt.AttrTypes {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		attrs := make([]string, len(keys))
+		for i, k := range keys {
+			attrs[i] = fmt.Sprintf("%s = %s", k, typeConstraint(tt.AttrTypes[k]))
+		}
+		return fmt.Sprintf("object({%s})", strings.Join(attrs, ", "))
+	default:
+		return "any"
+	}
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTypeConstraint(t *testing.T) {
+	for raw, want := range map[string]string{
+		`"hello"`:                    "string",
+		`42`:                         "number",
+		`true`:                       "bool",
+		`[1, 2]`:                     "list(number)",
+		`[]`:                         "list(any)",
+		`[1, "two"]`:                 "tuple([number, string])",
+		`{"b": [true], "a": "text"}`: "object({a = string, b = list(bool)})",
+	} {
+		attrType, _, diags := jsonRawToAttrValue(json.RawMessage(raw))
+		if diags.HasError() {
+			t.Fatalf("unexpected error for %s: %v", raw, diags)
+		}
+		if got := typeConstraint(attrType); got != want {
+			t.Errorf("expected %q for %s, got %q", want, raw, got)
+		}
+	}
+}
+
+func TestAccScalrWorkspaceOutputDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      `data scalr_output test { name = "greeting" }`,
+				ExpectError: regexp.MustCompile("Exactly one of these attributes must be configured"),
+				PlanOnly:    true,
+			},
+			{
+				Config: testAccScalrWorkspaceOutputDataSourceConfig(rInt, "greeting"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_output.test", "id"),
+					resource.TestCheckResourceAttrPair("data.scalr_output.test", "workspace_id", "scalr_workspace.test", "id"),
+					resource.TestCheckResourceAttr("data.scalr_output.test", "nonsensitive_value", "hello"),
+					resource.TestCheckResourceAttr("data.scalr_output.test", "sensitive", "false"),
+					resource.TestCheckResourceAttr("data.scalr_output.test", "type", "string"),
+				),
+			},
+			{
+				Config:      testAccScalrWorkspaceOutputDataSourceConfig(rInt, "missing"),
+				ExpectError: regexp.MustCompile("Output not found"),
+			},
+		},
+	})
+}
+
+func testAccScalrWorkspaceOutputDataSourceConfig(rInt int, name string) string {
+	return testAccScalrWorkspaceStateConfig(rInt, testAccScalrWorkspaceStateContent(1, "hello"), false) + fmt.Sprintf(`
+
+data scalr_output test {
+  environment = scalr_environment.test.name
+  workspace   = scalr_workspace.test.name
+  name        = %q
+
+  depends_on = [scalr_workspace_state.test]
+}`, name)
+}
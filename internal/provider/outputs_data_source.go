@@ -3,24 +3,36 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
+	"slices"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
+	scalrV2 "github.com/scalr/go-scalr/v2/scalr"
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/ops/workspace"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
 
 	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
 )
 
 var (
-	_ datasource.DataSource              = &workspaceOutputsDataSource{}
-	_ datasource.DataSourceWithConfigure = &workspaceOutputsDataSource{}
+	_ datasource.DataSource                     = &workspaceOutputsDataSource{}
+	_ datasource.DataSourceWithConfigure        = &workspaceOutputsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &workspaceOutputsDataSource{}
 )
 
 func newOutputsDataSource() datasource.DataSource {
@@ -34,46 +46,89 @@ type workspaceOutputsDataSource struct {
 
 type outputsModel struct {
 	ID                 types.String  `tfsdk:"id"`
+	WorkspaceID        types.String  `tfsdk:"workspace_id"`
 	Environment        types.String  `tfsdk:"environment"`
 	Workspace          types.String  `tfsdk:"workspace"`
+	Names              types.Set     `tfsdk:"names"`
+	FailOnMissing      types.Bool    `tfsdk:"fail_on_missing"`
 	Values             types.Dynamic `tfsdk:"values"`
 	NonSensitiveValues types.Dynamic `tfsdk:"nonsensitive_values"`
 }
 
+// workspaceOutput is an output of the current state version of a workspace.
+type workspaceOutput struct {
+	Name      string          `json:"name"`
+	Value     json.RawMessage `json:"value"`
+	Sensitive bool            `json:"sensitive"`
+}
+
 func (d *workspaceOutputsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_outputs"
 }
 
 func (d *workspaceOutputsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Retrieves the outputs of a Scalr workspace.",
+		MarkdownDescription: "Retrieves the outputs of a Scalr workspace." +
+			" The workspace is looked up either by `workspace_id`, or by the `environment` and `workspace` names.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the workspace.",
 				Computed:            true,
 			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace, in the format `ws-<RANDOM STRING>`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
 			"environment": schema.StringAttribute{
 				MarkdownDescription: "The name of the environment the workspace belongs to.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
 			},
 			"workspace": schema.StringAttribute{
 				MarkdownDescription: "The name of the workspace.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"names": schema.SetAttribute{
+				MarkdownDescription: "The names of the outputs to return. All outputs are returned if omitted.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"fail_on_missing": schema.BoolAttribute{
+				MarkdownDescription: "Fail if any of the `names` is not an output of the workspace. Defaults to `false`.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("names")),
+				},
 			},
 			"values": schema.DynamicAttribute{
-				MarkdownDescription: "A map of all workspace output values.",
+				MarkdownDescription: "A map of the workspace output values, limited to the outputs selected by `names` if it is set.",
 				Computed:            true,
 				Sensitive:           true,
 			},
 			"nonsensitive_values": schema.DynamicAttribute{
-				MarkdownDescription: "A map of non-sensitive workspace output values.",
+				MarkdownDescription: "A map of the non-sensitive workspace output values, limited to the outputs selected by `names` if it is set.",
 				Computed:            true,
 			},
 		},
 	}
 }
 
+func (d *workspaceOutputsDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return workspaceLookupConfigValidators()
+}
+
 func (d *workspaceOutputsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var cfg outputsModel
 
@@ -82,56 +137,48 @@ func (d *workspaceOutputsDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	workspaces, err := d.ClientV2.Workspace.GetWorkspaces(ctx, &workspace.GetWorkspacesOptions{
-		Filter: map[string]string{
-			"name":              cfg.Workspace.ValueString(),
-			"environment][name": cfg.Environment.ValueString(),
-		},
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Error listing workspaces", err.Error())
-		return
-	}
-	if len(workspaces) == 0 {
-		resp.Diagnostics.AddError(
-			"Workspace not found",
-			fmt.Sprintf(
-				"No workspace %q found in environment %q.",
-				cfg.Workspace.ValueString(),
-				cfg.Environment.ValueString(),
-			),
-		)
+	ws, diags := lookupOutputsWorkspace(ctx, d.ClientV2, cfg.WorkspaceID, cfg.Environment, cfg.Workspace)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
-	} else if len(workspaces) > 1 {
-		resp.Diagnostics.AddError(
-			"Multiple workspaces found",
-			fmt.Sprintf(
-				"Multiple workspaces %q found in environment %q.",
-				cfg.Workspace.ValueString(),
-				cfg.Environment.ValueString(),
-			),
-		)
 	}
 
-	wsID := workspaces[0].ID
-	cfg.ID = types.StringValue(wsID)
+	cfg.ID = types.StringValue(ws.ID)
+	cfg.WorkspaceID = types.StringValue(ws.ID)
+	cfg.Workspace = types.StringValue(ws.Attributes.Name)
+	if ws.Relationships.Environment != nil && ws.Relationships.Environment.Attributes.Name != "" {
+		cfg.Environment = types.StringValue(ws.Relationships.Environment.Attributes.Name)
+	}
 
-	outputsJSON, err := d.ClientV2.Workspace.GetWorkspaceOutputs(ctx, wsID)
+	outputs, err := getWorkspaceOutputs(ctx, d.ClientV2, ws.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading workspace outputs", err.Error())
 		return
 	}
 
-	var outputsResp struct {
-		Data []struct {
-			Name      string          `json:"name"`
-			Value     json.RawMessage `json:"value"`
-			Sensitive bool            `json:"sensitive"`
-		} `json:"data"`
+	var names []string
+	if !cfg.Names.IsNull() {
+		resp.Diagnostics.Append(cfg.Names.ElementsAs(ctx, &names, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
-	if err := json.Unmarshal([]byte(outputsJSON), &outputsResp); err != nil {
-		resp.Diagnostics.AddError("Error parsing workspace outputs", err.Error())
-		return
+
+	if cfg.FailOnMissing.ValueBool() {
+		var missing []string
+		for _, name := range names {
+			if !slices.ContainsFunc(outputs, func(o workspaceOutput) bool { return o.Name == name }) {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			resp.Diagnostics.AddError(
+				"Missing workspace outputs",
+				fmt.Sprintf("Workspace %s has no outputs %s.", ws.ID, strings.Join(missing, ", ")),
+			)
+			return
+		}
 	}
 
 	allAttrTypes := make(map[string]attr.Type)
@@ -139,7 +186,11 @@ func (d *workspaceOutputsDataSource) Read(ctx context.Context, req datasource.Re
 	nsAttrTypes := make(map[string]attr.Type)
 	nsAttrValues := make(map[string]attr.Value)
 
-	for _, output := range outputsResp.Data {
+	for _, output := range outputs {
+		if !cfg.Names.IsNull() && !slices.Contains(names, output.Name) {
+			continue
+		}
+
 		attrType, attrValue, diags := jsonRawToAttrValue(output.Value)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -168,6 +219,96 @@ func (d *workspaceOutputsDataSource) Read(ctx context.Context, req datasource.Re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
 }
 
+// workspaceLookupConfigValidators require the workspace to be set either by ID, or by name together with the environment name.
+func workspaceLookupConfigValidators() []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("workspace_id"),
+			path.MatchRoot("workspace"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("environment"),
+			path.MatchRoot("workspace"),
+		),
+	}
+}
+
+// lookupOutputsWorkspace finds the workspace by ID, or by its name and the name of its environment.
+// It fails unless exactly one workspace matches.
+func lookupOutputsWorkspace(
+	ctx context.Context, c *scalrV2.Client, workspaceID, environmentName, workspaceName types.String,
+) (*schemas.Workspace, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !workspaceID.IsNull() && !workspaceID.IsUnknown() {
+		ws, err := c.Workspace.GetWorkspace(ctx, workspaceID.ValueString(), &workspace.GetWorkspaceOptions{
+			Include: []string{"environment"},
+		})
+		if err != nil {
+			if errors.Is(err, client.ErrNotFound) {
+				diags.AddError("Workspace not found", fmt.Sprintf("No workspace with ID %q found.", workspaceID.ValueString()))
+				return nil, diags
+			}
+			diags.AddError("Error retrieving workspace", err.Error())
+			return nil, diags
+		}
+		return ws, diags
+	}
+
+	workspaces, err := c.Workspace.GetWorkspaces(ctx, &workspace.GetWorkspacesOptions{
+		Filter: map[string]string{
+			"name":              workspaceName.ValueString(),
+			"environment][name": environmentName.ValueString(),
+		},
+		Include: []string{"environment"},
+	})
+	if err != nil {
+		diags.AddError("Error listing workspaces", err.Error())
+		return nil, diags
+	}
+
+	switch len(workspaces) {
+	case 0:
+		diags.AddError(
+			"Workspace not found",
+			fmt.Sprintf(
+				"No workspace %q found in environment %q.",
+				workspaceName.ValueString(),
+				environmentName.ValueString(),
+			),
+		)
+		return nil, diags
+	case 1:
+		return workspaces[0], diags
+	default:
+		diags.AddError(
+			"Multiple workspaces found",
+			fmt.Sprintf(
+				"Multiple workspaces %q found in environment %q. Use workspace_id to select one of them.",
+				workspaceName.ValueString(),
+				environmentName.ValueString(),
+			),
+		)
+		return nil, diags
+	}
+}
+
+// getWorkspaceOutputs returns the outputs of the current state version of the workspace.
+func getWorkspaceOutputs(ctx context.Context, c *scalrV2.Client, workspaceID string) ([]workspaceOutput, error) {
+	outputsJSON, err := c.Workspace.GetWorkspaceOutputs(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var outputsResp struct {
+		Data []workspaceOutput `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(outputsJSON), &outputsResp); err != nil {
+		return nil, fmt.Errorf("error parsing workspace outputs: %w", err)
+	}
+	return outputsResp.Data, nil
+}
+
 func jsonRawToAttrValue(raw json.RawMessage) (attr.Type, attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
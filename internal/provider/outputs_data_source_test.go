@@ -214,12 +214,39 @@ func TestAccScalrWorkspaceOutputsDataSource_basic(t *testing.T) {
 				ExpectError: regexp.MustCompile("Workspace not found"),
 				PlanOnly:    true,
 			},
+			{
+				Config:      `data scalr_outputs test { workspace = "ws" }`,
+				ExpectError: regexp.MustCompile("These attributes must be configured together"),
+				PlanOnly:    true,
+			},
+			{
+				Config: `
+data scalr_outputs test {
+  workspace_id    = "ws-123"
+  fail_on_missing = true
+}`,
+				ExpectError: regexp.MustCompile(`Attribute "names" must be specified`),
+				PlanOnly:    true,
+			},
 			{
 				Config: testAccScalrWorkspaceOutputsDataSourceConfig(rInt),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.scalr_outputs.test", "id"),
 				),
 			},
+			{
+				Config: testAccScalrWorkspaceOutputsDataSourceByIDConfig(rInt, `["greeting"]`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.scalr_outputs.test", "id", "scalr_workspace.test", "id"),
+					resource.TestCheckResourceAttrPair("data.scalr_outputs.test", "workspace", "scalr_workspace.test", "name"),
+					resource.TestCheckResourceAttrPair("data.scalr_outputs.test", "environment", "scalr_environment.test", "name"),
+					resource.TestCheckResourceAttr("data.scalr_outputs.test", "nonsensitive_values.greeting", "hello"),
+				),
+			},
+			{
+				Config:      testAccScalrWorkspaceOutputsDataSourceByIDConfig(rInt, `["greeting", "missing"]`, true),
+				ExpectError: regexp.MustCompile("Missing workspace outputs"),
+			},
 		},
 	})
 }
@@ -249,3 +276,13 @@ data scalr_outputs test {
   workspace   = scalr_workspace.test.name
 }`, rInt, defaultAccount)
 }
+
+func testAccScalrWorkspaceOutputsDataSourceByIDConfig(rInt int, names string, failOnMissing bool) string {
+	return testAccScalrWorkspaceStateConfig(rInt, testAccScalrWorkspaceStateContent(1, "hello"), false) + fmt.Sprintf(`
+
+data scalr_outputs test {
+  workspace_id    = scalr_workspace_state.test.workspace_id
+  names           = %s
+  fail_on_missing = %t
+}`, names, failOnMissing)
+}
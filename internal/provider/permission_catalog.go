@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	scalrV2 "github.com/scalr/go-scalr/v2/scalr"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+)
+
+// permissionCatalog holds the IAM permissions that can be granted by a role.
+type permissionCatalog struct {
+	permissions []*schemas.Permission // sorted by ID
+	byID        map[string]*schemas.Permission
+}
+
+// getPermissionCatalog fetches the permission catalog once per provider instance.
+func getPermissionCatalog(
+	ctx context.Context, c *scalrV2.Client, cache *framework.LookupCache,
+) (*permissionCatalog, error) {
+	return framework.CachedLookup(ctx, cache, framework.LookupPermissions, "", func() (*permissionCatalog, error) {
+		permissions, err := c.Permission.GetPermissions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return newPermissionCatalog(permissions), nil
+	})
+}
+
+func newPermissionCatalog(permissions []*schemas.Permission) *permissionCatalog {
+	c := &permissionCatalog{
+		permissions: make([]*schemas.Permission, 0, len(permissions)),
+		byID:        make(map[string]*schemas.Permission, len(permissions)),
+	}
+	for _, p := range permissions {
+		if p == nil {
+			continue
+		}
+		c.permissions = append(c.permissions, p)
+		c.byID[p.ID] = p
+	}
+	sort.Slice(c.permissions, func(i, j int) bool {
+		return c.permissions[i].ID < c.permissions[j].ID
+	})
+	return c
+}
+
+// permissionObjectType returns the object type part of the permission ID, e.g. `workspaces` for `workspaces:read`.
+func permissionObjectType(id string) string {
+	objectType, _, _ := strings.Cut(id, ":")
+	return objectType
+}
+
+// isPermissionDeprecated reports whether the permission is deprecated.
+// The API has no dedicated flag for that and marks such permissions in the description instead,
+// so the result is a best guess and must only be used for warnings.
+func isPermissionDeprecated(p *schemas.Permission) bool {
+	if p.Attributes.Description == nil {
+		return false
+	}
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(*p.Attributes.Description)), "deprecated")
+}
+
+// isWildcardPermission reports whether the permission ID contains a wildcard, such as `workspaces:*` or `*:read`.
+func isWildcardPermission(id string) bool {
+	return strings.Contains(id, "*")
+}
+
+// implies returns the IDs of the other permissions granted by the given one.
+// Only wildcard permissions, such as `workspaces:*` or `*:read`, imply others.
+// The API does not expose the permission hierarchy, so it is matched by the ID parts.
+func (c *permissionCatalog) implies(id string) []string {
+	objectType, action, ok := strings.Cut(id, ":")
+	if !ok || (objectType != "*" && action != "*") {
+		return nil
+	}
+
+	implied := make([]string, 0)
+	for _, p := range c.permissions {
+		if p.ID == id || strings.Contains(p.ID, "*") {
+			continue
+		}
+		pObjectType, pAction, _ := strings.Cut(p.ID, ":")
+		if (objectType == "*" || objectType == pObjectType) && (action == "*" || action == pAction) {
+			implied = append(implied, p.ID)
+		}
+	}
+	return implied
+}
+
+// isListed reports whether the permission is in the catalog.
+func (c *permissionCatalog) isListed(id string) bool {
+	_, ok := c.byID[id]
+	return ok
+}
+
+// isKnown reports whether the permission is in the catalog
+// or is a wildcard matching at least one permission from the catalog.
+func (c *permissionCatalog) isKnown(id string) bool {
+	return c.isListed(id) || len(c.implies(id)) > 0
+}
+
+// isDeprecated reports whether the permission is in the catalog and is deprecated.
+func (c *permissionCatalog) isDeprecated(id string) bool {
+	p, ok := c.byID[id]
+	return ok && isPermissionDeprecated(p)
+}
+
+// suggest returns up to three permissions to use instead of the given one.
+// For a deprecated permission, these are the permissions mentioned in its description,
+// otherwise the closest permission IDs by edit distance. Both are guesses, so they are only hints.
+func (c *permissionCatalog) suggest(id string) []string {
+	suggestions := make([]string, 0, 3)
+
+	if p, ok := c.byID[id]; ok && p.Attributes.Description != nil {
+		for _, candidate := range c.permissions {
+			if len(suggestions) == cap(suggestions) {
+				break
+			}
+			if candidate.ID != id && !isPermissionDeprecated(candidate) &&
+				strings.Contains(*p.Attributes.Description, candidate.ID) {
+				suggestions = append(suggestions, candidate.ID)
+			}
+		}
+		return suggestions
+	}
+
+	type match struct {
+		id       string
+		distance int
+	}
+	maxDistance := max(2, len(id)/4)
+	matches := make([]match, 0)
+	for _, candidate := range c.permissions {
+		if isPermissionDeprecated(candidate) {
+			continue
+		}
+		if d := editDistance(id, candidate.ID); d <= maxDistance {
+			matches = append(matches, match{id: candidate.ID, distance: d})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].distance < matches[j].distance
+	})
+	for _, m := range matches {
+		if len(suggestions) == cap(suggestions) {
+			break
+		}
+		suggestions = append(suggestions, m.id)
+	}
+	return suggestions
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource              = &permissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &permissionsDataSource{}
+)
+
+func newPermissionsDataSource() datasource.DataSource {
+	return &permissionsDataSource{}
+}
+
+// permissionsDataSource defines the data source implementation.
+type permissionsDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// permissionsDataSourceModel describes the data source data model.
+type permissionsDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	ObjectType  types.String `tfsdk:"object_type"`
+	IDs         types.Set    `tfsdk:"ids"`
+	Permissions types.List   `tfsdk:"permissions"`
+}
+
+// permissionModel describes a single permission in the list.
+type permissionModel struct {
+	Id               types.String `tfsdk:"id"`
+	Description      types.String `tfsdk:"description"`
+	ObjectType       types.String `tfsdk:"object_type"`
+	ApplicableScopes types.List   `tfsdk:"applicable_scopes"`
+	Implies          types.List   `tfsdk:"implies"`
+	Deprecated       types.Bool   `tfsdk:"deprecated"`
+}
+
+var permissionElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                types.StringType,
+		"description":       types.StringType,
+		"object_type":       types.StringType,
+		"applicable_scopes": types.ListType{ElemType: types.StringType},
+		"implies":           types.ListType{ElemType: types.StringType},
+		"deprecated":        types.BoolType,
+	},
+}
+
+func (d *permissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (d *permissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the catalog of IAM permissions that can be granted by a `scalr_role`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of this data source.",
+				Computed:            true,
+			},
+			"object_type": schema.StringAttribute{
+				MarkdownDescription: "Only return permissions for this object type, e.g. `workspaces`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "The list of permission IDs, e.g. [`workspaces:read`, `workspaces:update`].",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"permissions": schema.ListAttribute{
+				MarkdownDescription: "The list of permissions, sorted by ID. Each permission has the `id`, `description`," +
+					" `object_type`, the `applicable_scopes` it can be granted on, the permissions it `implies`" +
+					" and whether it is `deprecated`. Only wildcard permissions such as `workspaces:*` imply others.",
+				ElementType: permissionElementType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *permissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg permissionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalog, err := getPermissionCatalog(ctx, d.ClientV2, d.LookupCache)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving permissions", err.Error())
+		return
+	}
+
+	permissions := make([]permissionModel, 0)
+	ids := make([]string, 0)
+	for _, p := range catalog.permissions {
+		objectType := permissionObjectType(p.ID)
+		if !cfg.ObjectType.IsNull() && cfg.ObjectType.ValueString() != objectType {
+			continue
+		}
+
+		scopes := make([]string, len(p.Attributes.ApplicableScopes))
+		for i, scope := range p.Attributes.ApplicableScopes {
+			scopes[i] = string(scope)
+		}
+		scopesValue, diags := types.ListValueFrom(ctx, types.StringType, scopes)
+		resp.Diagnostics.Append(diags...)
+
+		impliesValue, diags := types.ListValueFrom(ctx, types.StringType, catalog.implies(p.ID))
+		resp.Diagnostics.Append(diags...)
+
+		permissions = append(permissions, permissionModel{
+			Id:               types.StringValue(p.ID),
+			Description:      types.StringPointerValue(p.Attributes.Description),
+			ObjectType:       types.StringValue(objectType),
+			ApplicableScopes: scopesValue,
+			Implies:          impliesValue,
+			Deprecated:       types.BoolValue(isPermissionDeprecated(p)),
+		})
+		ids = append(ids, p.ID)
+	}
+
+	cfg.Id = types.StringValue(fmt.Sprintf("%d", framework.HashString("permissions"+cfg.ObjectType.ValueString())))
+
+	idsValue, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	cfg.IDs = idsValue
+
+	permissionsValue, diags := types.ListValueFrom(ctx, permissionElementType, permissions)
+	resp.Diagnostics.Append(diags...)
+	cfg.Permissions = permissionsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
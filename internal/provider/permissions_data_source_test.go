@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+)
+
+func TestPermissionCatalog(t *testing.T) {
+	description := func(s string) *string { return &s }
+	catalog := newPermissionCatalog([]*schemas.Permission{
+		{ID: "workspaces:read"},
+		{ID: "workspaces:update"},
+		{ID: "workspaces:*"},
+		{ID: "environments:read"},
+		{ID: "runs:create", Attributes: schemas.PermissionAttributes{
+			Description: description("Deprecated, use workspaces:update instead."),
+		}},
+	})
+
+	if got := catalog.implies("workspaces:*"); !slices.Equal(got, []string{"workspaces:read", "workspaces:update"}) {
+		t.Errorf("unexpected permissions implied by workspaces:*: %v", got)
+	}
+	if got := catalog.implies("*:read"); !slices.Equal(got, []string{"environments:read", "workspaces:read"}) {
+		t.Errorf("unexpected permissions implied by *:read: %v", got)
+	}
+	if got := catalog.implies("workspaces:read"); len(got) != 0 {
+		t.Errorf("expected workspaces:read to imply nothing, got: %v", got)
+	}
+
+	for _, id := range []string{"workspaces:read", "*:read", "*:*", "environments:*"} {
+		if !catalog.isKnown(id) {
+			t.Errorf("expected %s to be known", id)
+		}
+	}
+	for _, id := range []string{"workspaces:raed", "*:destroy", "modules:*"} {
+		if catalog.isKnown(id) {
+			t.Errorf("expected %s to be unknown", id)
+		}
+	}
+
+	if !catalog.isListed("workspaces:*") || catalog.isListed("*:read") {
+		t.Error("unexpected catalog membership")
+	}
+	if !isWildcardPermission("*:read") || isWildcardPermission("workspaces:read") {
+		t.Error("unexpected wildcard detection")
+	}
+
+	if !catalog.isDeprecated("runs:create") || catalog.isDeprecated("workspaces:read") {
+		t.Error("unexpected deprecation status")
+	}
+	if got := catalog.suggest("runs:create"); !slices.Equal(got, []string{"workspaces:update"}) {
+		t.Errorf("unexpected suggestions for runs:create: %v", got)
+	}
+	if got := catalog.suggest("workspaces:raed"); len(got) == 0 || got[0] != "workspaces:read" {
+		t.Errorf("unexpected suggestions for workspaces:raed: %v", got)
+	}
+	if got := catalog.suggest("something:else"); len(got) != 0 {
+		t.Errorf("expected no suggestions for something:else, got: %v", got)
+	}
+}
+
+// TestAccPermissionCatalog_deprecationMarker pins the description prefix used by isPermissionDeprecated
+// to the live catalog: the API has no deprecation flag, so the convention must be checked against real entries.
+func TestAccPermissionCatalog_deprecationMarker(t *testing.T) {
+	if !isAccTest() {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
+	}
+
+	permissions, err := createScalrClientV2().Permission.GetPermissions(context.Background())
+	if err != nil {
+		t.Fatalf("Error retrieving permissions: %v", err)
+	}
+
+	var deprecated []string
+	for _, p := range permissions {
+		if p == nil || p.Attributes.Description == nil {
+			continue
+		}
+		mentioned := strings.Contains(strings.ToLower(*p.Attributes.Description), "deprecated")
+		if mentioned && !isPermissionDeprecated(p) {
+			t.Errorf("Permission %s mentions deprecation but is not detected as deprecated: %q", p.ID, *p.Attributes.Description)
+		}
+		if isPermissionDeprecated(p) {
+			deprecated = append(deprecated, p.ID)
+		}
+	}
+	if len(deprecated) == 0 {
+		t.Error("No deprecated permission found in the catalog, the description prefix convention may have changed")
+	}
+}
+
+func TestAccScalrPermissionsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data scalr_permissions all {}
+
+data scalr_permissions workspaces {
+  object_type = "workspaces"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_permissions.all", "id"),
+					resource.TestCheckTypeSetElemAttr("data.scalr_permissions.all", "ids.*", "workspaces:read"),
+					resource.TestCheckTypeSetElemAttr("data.scalr_permissions.all", "ids.*", "environments:read"),
+					resource.TestCheckTypeSetElemAttr("data.scalr_permissions.workspaces", "ids.*", "workspaces:read"),
+					resource.TestCheckResourceAttr("data.scalr_permissions.workspaces", "permissions.0.object_type", "workspaces"),
+					resource.TestCheckResourceAttrSet("data.scalr_permissions.workspaces", "permissions.0.description"),
+				),
+			},
+		},
+	})
+}
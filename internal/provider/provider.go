@@ -21,10 +21,12 @@ import (
 var _ provider.Provider = &scalrProvider{}
 
 // New returns a function that creates a Scalr provider instance with version v.
-func New(v string) func() provider.Provider {
+// The lookup cache is shared with the SDKv2 half of the muxed provider, see Provider.
+func New(v string, lookupCache *framework.LookupCache) func() provider.Provider {
 	return func() provider.Provider {
 		return &scalrProvider{
-			version: v,
+			version:     v,
+			lookupCache: lookupCache,
 		}
 	}
 }
@@ -37,7 +39,8 @@ type scalrProviderModel struct {
 
 // scalrProvider implements the Terraform plugin framework Provider interface.
 type scalrProvider struct {
-	version string
+	version     string
+	lookupCache *framework.LookupCache
 }
 
 func (p *scalrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -143,8 +146,9 @@ func (p *scalrProvider) Configure(ctx context.Context, req provider.ConfigureReq
 
 	// Make the Scalr client available during DataSource and Resource Configure methods.
 	clients := framework.Clients{
-		Client:   scalrClient,
-		ClientV2: scalrClientV2,
+		Client:      scalrClient,
+		ClientV2:    scalrClientV2,
+		LookupCache: p.lookupCache,
 	}
 	resp.DataSourceData = &clients
 	resp.ResourceData = &clients
@@ -157,40 +161,68 @@ func (p *scalrProvider) Resources(_ context.Context) []func() resource.Resource
 		newAgentPoolTokenResource,
 		newAssumeServiceAccountPolicyResource,
 		newCheckovIntegrationResource,
+		newConfigurationVersionResource,
 		newDriftDetectionResource,
 		newEnvironmentHookResource,
 		newEnvironmentResource,
+		newEnvironmentTagResource,
+		newEventBridgeIntegrationResource,
 		newFederatedEnvironmentsResource,
 		newHookResource,
+		newIamTeamMemberResource,
 		newIamTeamResource,
+		newIamUserResource,
 		newIntegrationInfracostResource,
 		newModuleNamespaceResource,
 		newRoleResource,
+		newSSHKeyResource,
 		newStorageProfileResource,
 		newTagResource,
 		newVarSetResource,
 		newVariableResource,
 		newWorkloadIdentityProviderResource,
+		newWorkspaceProviderConfigurationResource,
+		newWorkspaceRemoteStateConsumerResource,
 		newWorkspaceResource,
+		newWorkspaceSSHKeyResource,
+		newWorkspaceStateResource,
+		newWorkspaceTagResource,
 		newWorkspaceVarSetResource,
 	}
 }
 
 func (p *scalrProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		newAgentPoolsDataSource,
+		newAgentsDataSource,
 		newAssumeServiceAccountPolicyDataSource,
+		newDriftDetectionResultsDataSource,
 		newEnvironmentDataSource,
 		newEnvironmentsDataSource,
 		newHookDataSource,
+		newHooksDataSource,
 		newIamTeamDataSource,
+		newIamTeamsDataSource,
+		newIamUsersDataSource,
 		newIntegrationInfracostDataSource,
 		newModuleNamespaceDataSource,
+		newOutputDataSource,
 		newOutputsDataSource,
+		newPermissionsDataSource,
 		newProviderConfigurationDataSource,
+		newRolesDataSource,
+		newRunDataSource,
+		newRunsDataSource,
+		newServiceAccountsDataSource,
+		newStateVersionsDataSource,
 		newStorageProfileDataSource,
+		newStorageProfilesDataSource,
 		newTagDataSource,
 		newVarSetDataSource,
 		newVcsProviderDataSource,
+		newVcsProvidersDataSource,
+		newWebhookDeliveriesDataSource,
+		newWebhooksDataSource,
 		newWorkloadIdentityProviderDataSource,
 	}
 }
@@ -8,10 +8,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/scalr/terraform-provider-scalr/internal/client"
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 // Provider returns a terraform.ResourceProvider with version v.
-func Provider(v string) *schema.Provider {
+// Name-based lookups are cached in lookupCache, which may be nil to disable caching.
+func Provider(v string, lookupCache *framework.LookupCache) *schema.Provider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"hostname": {
@@ -74,15 +76,15 @@ func Provider(v string) *schema.Provider {
 			"scalr_webhook":                        resourceScalrWebhook(),
 			"scalr_workspace_run_schedule":         resourceScalrWorkspaceRunSchedule(),
 			"scalr_run_schedule_rule":              resourceScalrRunScheduleRule(),
-			"scalr_event_bridge_integration":       resourceScalrEventBridgeIntegration(),
-			"scalr_ssh_key":                        resourceScalrSSHKey(),
 		},
 
-		ConfigureContextFunc: providerConfigure(v),
+		ConfigureContextFunc: providerConfigure(v, lookupCache),
 	}
 }
 
-func providerConfigure(v string) func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+func providerConfigure(
+	v string, lookupCache *framework.LookupCache,
+) func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	return func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		h := d.Get("hostname").(string)
 		t := d.Get("token").(string)
@@ -93,6 +95,9 @@ func providerConfigure(v string) func(ctx context.Context, d *schema.ResourceDat
 			return nil, diag.FromErr(err)
 		}
 
-		return scalrClient, nil
+		return &framework.Clients{
+			Client:      scalrClient,
+			LookupCache: lookupCache,
+		}, nil
 	}
 }
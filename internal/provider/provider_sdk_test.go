@@ -21,22 +21,22 @@ var githubToken = os.Getenv("githubToken")
 var ctx = context.Background()
 
 func init() {
-	testAccProviderSDK = Provider(testProviderVersion)
+	testAccProviderSDK = Provider(testProviderVersion, nil)
 }
 
 func TestProvider(t *testing.T) {
-	if err := Provider(testProviderVersion).InternalValidate(); err != nil {
+	if err := Provider(testProviderVersion, nil).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
 
 func TestProvider_impl(t *testing.T) {
-	var _ = Provider(testProviderVersion)
+	var _ = Provider(testProviderVersion, nil)
 }
 
 func testAccPreCheck(t *testing.T) {
 	// The credentials must be provided by the CLI config file for testing.
-	if diags := Provider(testProviderVersion).Configure(context.Background(), &terraform.ResourceConfig{}); diags.HasError() {
+	if diags := Provider(testProviderVersion, nil).Configure(context.Background(), &terraform.ResourceConfig{}); diags.HasError() {
 		for _, d := range diags {
 			if d.Severity == diag.Error {
 				t.Fatalf("err: %s", d.Summary)
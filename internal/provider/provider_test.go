@@ -17,7 +17,9 @@ var testAccProvider provider.Provider
 
 func init() {
 	schema.DescriptionKind = schema.StringMarkdown
-	testAccProvider = New(testProviderVersion)()
+	// The test providers don't cache lookups: the tests change objects directly through
+	// the API clients, so cached results could outlive them and depend on the test order.
+	testAccProvider = New(testProviderVersion, nil)()
 }
 
 func protoV5ProviderFactories(t *testing.T) map[string]func() (tfprotov5.ProviderServer, error) {
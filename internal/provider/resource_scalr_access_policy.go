@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 type Scope string
@@ -145,7 +147,7 @@ func parseRoleIdDefinitions(d *schema.ResourceData) ([]*scalr.Role, error) {
 }
 
 func resourceScalrAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	subject := d.Get("subject").([]interface{})[0].(map[string]interface{})
 	subjectType := subject["type"].(string)
@@ -192,7 +194,7 @@ func resourceScalrAccessPolicyCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceScalrAccessPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Read configuration of access policy: %s", id)
@@ -256,7 +258,7 @@ func resourceScalrAccessPolicyRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceScalrAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 
@@ -281,7 +283,7 @@ func resourceScalrAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceScalrAccessPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete access policy %s", id)
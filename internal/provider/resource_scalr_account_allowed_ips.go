@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrAccountAllowedIps() *schema.Resource {
@@ -61,7 +63,7 @@ func preprocessAllowedIps(allowedIps []interface{}) []string {
 }
 
 func resourceScalrAccountAllowedIpsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	// Get attributes.
 	accountId := d.Get("account_id").(string)
@@ -85,7 +87,7 @@ func resourceScalrAccountAllowedIpsCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceScalrAccountAllowedIpsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	// Get the ID
 	accountID := d.Id()
@@ -115,7 +117,7 @@ func resourceScalrAccountAllowedIpsRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceScalrAccountAllowedIpsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	// Get attributes.
 	allowedIps := preprocessAllowedIps(d.Get("allowed_ips").([]interface{}))
@@ -136,7 +138,7 @@ func resourceScalrAccountAllowedIpsUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceScalrAccountAllowedIpsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	log.Printf("[DEBUG] Delete allowed ips for account: %s", d.Id())
 
@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrAgentPool() *schema.Resource {
@@ -114,7 +116,7 @@ func parsePoolHeaders(d *schema.ResourceData) []*scalr.AgentPoolHeader {
 }
 
 func resourceScalrAgentPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	var envID string
 
 	// Get required options
@@ -178,7 +180,7 @@ func resourceScalrAgentPoolCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScalrAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 	log.Printf("[DEBUG] Read configuration of agent pool: %s", id)
 	agentPool, err := scalrClient.AgentPools.Read(ctx, id)
@@ -241,7 +243,7 @@ func resourceScalrAgentPoolRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceScalrAgentPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 
@@ -305,7 +307,7 @@ func resourceScalrAgentPoolUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScalrAgentPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete agent pool %s", id)
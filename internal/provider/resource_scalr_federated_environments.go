@@ -75,6 +75,8 @@ func (r *federatedEnvironmentsResource) ConfigValidators(_ context.Context) []re
 }
 
 func (r *federatedEnvironmentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupEnvironments)
+
 	var plan federatedEnvironmentsResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -195,6 +197,8 @@ func (r *federatedEnvironmentsResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *federatedEnvironmentsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupEnvironments)
+
 	var plan, state federatedEnvironmentsResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -264,6 +268,7 @@ func (r *federatedEnvironmentsResource) Update(ctx context.Context, req resource
 }
 
 func (r *federatedEnvironmentsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupEnvironments)
 
 	var state federatedEnvironmentsResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
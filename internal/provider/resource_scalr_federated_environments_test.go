@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestFederatedEnvironmentsResource_basic(t *testing.T) {
@@ -81,7 +83,7 @@ func TestFederatedEnvironmentsResource_shared(t *testing.T) {
 
 func testCheckScalrFederatedEnvironmentsExists(resId string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		rs, ok := s.RootModule().Resources[resId]
 		if !ok {
@@ -107,7 +109,7 @@ func testCheckScalrFederatedEnvironmentsExists(resId string) resource.TestCheckF
 
 func testCheckScalrEnvironmentSharedToAccount(resId string, expected bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		rs, ok := s.RootModule().Resources[resId]
 		if !ok {
@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrModule() *schema.Resource {
@@ -122,7 +124,7 @@ func resourceScalrModule() *schema.Resource {
 }
 
 func resourceScalrModuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	vcsRepo := d.Get("vcs_repo").([]interface{})[0].(map[string]interface{})
 	vcsOpt := &scalr.ModuleVCSRepo{
@@ -167,7 +169,7 @@ func resourceScalrModuleCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceScalrModuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 	log.Printf("[DEBUG] Read configuration of module: %s", id)
 	m, err := scalrClient.Modules.Read(ctx, id, scalr.ModuleReadOptions{})
@@ -206,7 +208,7 @@ func resourceScalrModuleRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceScalrModuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete module %s", id)
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccScalrModule_basic(t *testing.T) {
@@ -104,7 +106,7 @@ func TestAccScalrModule_withNamespace(t *testing.T) {
 
 func testAccCheckScalrModuleExists(moduleId string, module *scalr.Module) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		rs, ok := s.RootModule().Resources[moduleId]
 		if !ok {
@@ -128,7 +130,7 @@ func testAccCheckScalrModuleExists(moduleId string, module *scalr.Module) resour
 }
 
 func testAccCheckScalrModuleDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_module" {
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrPolicyGroup() *schema.Resource {
@@ -125,7 +127,7 @@ func resourceScalrPolicyGroup() *schema.Resource {
 }
 
 func resourceScalrPolicyGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	// Get required options
 	name := d.Get("name").(string)
@@ -206,7 +208,7 @@ func resourceScalrPolicyGroupCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceScalrPolicyGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 	log.Printf("[DEBUG] Read configuration of policy group %s", id)
@@ -261,7 +263,7 @@ func resourceScalrPolicyGroupRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScalrPolicyGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 
@@ -341,7 +343,7 @@ func resourceScalrPolicyGroupUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceScalrPolicyGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete policy group %s", id)
@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrPolicyGroupLinkage() *schema.Resource {
@@ -48,7 +50,7 @@ func resourceScalrPolicyGroupLinkage() *schema.Resource {
 }
 
 func resourceScalrPolicyGroupLinkageImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 
@@ -67,7 +69,9 @@ func resourceScalrPolicyGroupLinkageImport(ctx context.Context, d *schema.Resour
 }
 
 func resourceScalrPolicyGroupLinkageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupEnvironments)
 
 	pgID := d.Get("policy_group_id").(string)
 	envID := d.Get("environment_id").(string)
@@ -87,7 +91,7 @@ func resourceScalrPolicyGroupLinkageCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceScalrPolicyGroupLinkageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 
@@ -108,7 +112,9 @@ func resourceScalrPolicyGroupLinkageRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceScalrPolicyGroupLinkageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupEnvironments)
 
 	id := d.Id()
 
@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 const numParallel = 10
@@ -435,7 +437,7 @@ func resourceScalrProviderConfiguration() *schema.Resource {
 }
 
 func resourceScalrProviderConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	name := d.Get("name").(string)
 	accountID := d.Get("account_id").(string)
@@ -685,7 +687,7 @@ func resourceScalrProviderConfigurationCreate(ctx context.Context, d *schema.Res
 }
 
 func resourceScalrProviderConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 
 	providerConfiguration, err := scalrClient.ProviderConfigurations.Read(ctx, id)
@@ -909,7 +911,7 @@ func resourceScalrProviderConfigurationRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceScalrProviderConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 
@@ -1217,7 +1219,7 @@ func syncArguments(ctx context.Context, providerConfigurationId string, custom m
 }
 
 func resourceScalrProviderConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 
 	err := scalrClient.ProviderConfigurations.Delete(ctx, id)
@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 var resourceScalrProviderConfigurationDefaultMutex sync.Mutex
@@ -51,7 +53,7 @@ func resourceScalrProviderConfigurationDefault() *schema.Resource {
 }
 
 func resourceScalrProviderConfigurationDefaultImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 
@@ -72,7 +74,9 @@ func resourceScalrProviderConfigurationDefaultImport(ctx context.Context, d *sch
 func resourceScalrProviderConfigurationDefaultCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	resourceScalrProviderConfigurationDefaultMutex.Lock()
 	defer resourceScalrProviderConfigurationDefaultMutex.Unlock()
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupEnvironments)
 
 	providerConfigurationID := d.Get("provider_configuration_id").(string)
 	environmentID := d.Get("environment_id").(string)
@@ -114,7 +118,7 @@ func resourceScalrProviderConfigurationDefaultCreate(ctx context.Context, d *sch
 }
 
 func resourceScalrProviderConfigurationDefaultRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 
@@ -144,7 +148,9 @@ func resourceScalrProviderConfigurationDefaultRead(ctx context.Context, d *schem
 func resourceScalrProviderConfigurationDefaultDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	resourceScalrProviderConfigurationDefaultMutex.Lock()
 	defer resourceScalrProviderConfigurationDefaultMutex.Unlock()
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupEnvironments)
 
 	providerConfigurationID := d.Get("provider_configuration_id").(string)
 	environmentID := d.Get("environment_id").(string)
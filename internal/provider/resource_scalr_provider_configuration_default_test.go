@@ -6,7 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
-	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccProviderConfigurationDefault_basic(t *testing.T) {
@@ -62,7 +63,7 @@ func testAccCheckProviderConfigurationDefaultExists(
 			return fmt.Errorf("Not found: %s", rn)
 		}
 
-		client := testAccProviderSDK.Meta().(*scalr.Client)
+		client := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		providerConfigurationID := rs.Primary.Attributes["provider_configuration_id"]
 		environmentID := rs.Primary.Attributes["environment_id"]
@@ -83,7 +84,7 @@ func testAccCheckProviderConfigurationDefaultExists(
 }
 
 func testAccCheckProviderConfigurationDefaultDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_provider_configuration_default" {
@@ -14,6 +14,7 @@ import (
 	"github.com/scalr/go-scalr"
 
 	"github.com/scalr/terraform-provider-scalr/internal/client"
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccProviderConfiguration_import(t *testing.T) {
@@ -892,7 +893,7 @@ func testAccCheckProviderConfigurationExists(n string, providerConfiguration *sc
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		providerConfigurationResource, err := scalrClient.ProviderConfigurations.Read(ctx, rs.Primary.ID)
 
@@ -907,7 +908,7 @@ func testAccCheckProviderConfigurationExists(n string, providerConfiguration *sc
 }
 
 func testAccCheckProviderConfigurationResourceDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_provider_configuration" {
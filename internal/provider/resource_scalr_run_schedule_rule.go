@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrRunScheduleRule() *schema.Resource {
@@ -49,7 +51,7 @@ func resourceScalrRunScheduleRule() *schema.Resource {
 }
 
 func resourceScalrRunScheduleRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Read run schedule rule: %s", id)
@@ -71,7 +73,7 @@ func resourceScalrRunScheduleRuleRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceScalrRunScheduleRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	// Create a new options struct.
 	options := scalr.RunScheduleRuleCreateOptions{
@@ -93,7 +95,7 @@ func resourceScalrRunScheduleRuleCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceScalrRunScheduleRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 	if d.HasChange("schedule") || d.HasChange("schedule_mode") {
@@ -119,7 +121,7 @@ func resourceScalrRunScheduleRuleUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceScalrRunScheduleRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete run schedule rule %s", id)
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccScalrRunScheduleRule_basic(t *testing.T) {
@@ -96,7 +98,7 @@ func testAccCheckScalrRunScheduleRuleExists(resId string, rule *scalr.RunSchedul
 			return fmt.Errorf("No Run Schedule Rule ID is set")
 		}
 
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 		r, err := scalrClient.RunScheduleRules.Read(ctx, rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Error reading run schedule rule: %v", err)
@@ -109,7 +111,7 @@ func testAccCheckScalrRunScheduleRuleExists(resId string, rule *scalr.RunSchedul
 }
 
 func testAccCheckScalrRunScheduleRuleDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_run_schedule_rule" {
@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrRunTrigger() *schema.Resource {
@@ -44,7 +46,7 @@ func resourceScalrRunTrigger() *schema.Resource {
 }
 
 func resourceScalrRunTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	downstreamID := d.Get("downstream_id").(string)
 	upstreamID := d.Get("upstream_id").(string)
@@ -66,7 +68,7 @@ func resourceScalrRunTriggerCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceScalrRunTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 
@@ -84,7 +86,7 @@ func resourceScalrRunTriggerDelete(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceScalrRunTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrServiceAccount() *schema.Resource {
@@ -96,7 +98,7 @@ func resourceScalrServiceAccount() *schema.Resource {
 }
 
 func resourceScalrServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Read service account: %s", id)
@@ -137,7 +139,7 @@ func resourceScalrServiceAccountRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceScalrServiceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	name := d.Get("name").(string)
 	accountID := d.Get("account_id").(string)
@@ -176,7 +178,7 @@ func resourceScalrServiceAccountCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceScalrServiceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 
@@ -214,7 +216,7 @@ func resourceScalrServiceAccountUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceScalrServiceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete service account %s", id)
@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrServiceAccountToken() *schema.Resource {
@@ -52,7 +54,7 @@ func resourceScalrServiceAccountToken() *schema.Resource {
 }
 
 func resourceScalrServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	saID := d.Get("service_account_id").(string)
 
@@ -83,7 +85,7 @@ func resourceScalrServiceAccountTokenCreate(ctx context.Context, d *schema.Resou
 }
 
 func resourceScalrServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 	saID := d.Get("service_account_id").(string)
 
@@ -130,7 +132,7 @@ func resourceScalrServiceAccountTokenRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceScalrServiceAccountTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	id := d.Id()
 
@@ -159,7 +161,7 @@ func resourceScalrServiceAccountTokenUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceScalrServiceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete service account access token %s", id)
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrSlackIntegration() *schema.Resource {
@@ -137,7 +139,7 @@ func parseWorkspaces(d *schema.ResourceData) []*scalr.Workspace {
 }
 
 func resourceScalrSlackIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	// Get attributes.
 	name := d.Get("name").(string)
 	accountID := d.Get("account_id").(string)
@@ -181,7 +183,7 @@ func resourceScalrSlackIntegrationCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceScalrSlackIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	integrationID := d.Id()
 
 	log.Printf("[DEBUG] Read slack integration with ID: %s", integrationID)
@@ -214,7 +216,7 @@ func resourceScalrSlackIntegrationRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceScalrSlackIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	options := scalr.SlackIntegrationUpdateOptions{}
 
 	if d.HasChange("name") {
@@ -254,7 +256,7 @@ func resourceScalrSlackIntegrationUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceSlackIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	log.Printf("[DEBUG] Delete slack integration: %s", d.Id())
 	err := scalrClient.SlackIntegrations.Delete(ctx, d.Id())
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrVcsProvider() *schema.Resource {
@@ -114,7 +116,9 @@ func resourceScalrVcsProvider() *schema.Resource {
 }
 
 func resourceScalrVcsProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupVcsProviders)
 	// Get attributes.
 	name := d.Get("name").(string)
 	token := d.Get("token").(string)
@@ -185,7 +189,7 @@ func resourceScalrVcsProviderCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceScalrVcsProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	providerID := d.Id()
 
 	log.Printf("[DEBUG] Read vcs provider with ID: %s", providerID)
@@ -226,7 +230,9 @@ func resourceScalrVcsProviderRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScalrVcsProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupVcsProviders)
 	// Create a new options' struct.
 	options := scalr.VcsProviderUpdateOptions{
 		Name:  ptr(d.Get("name").(string)),
@@ -293,7 +299,9 @@ func resourceScalrVcsProviderUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceVcsProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupVcsProviders)
 
 	log.Printf("[DEBUG] Delete vcs provider: %s", d.Id())
 	err := scalrClient.VcsProviders.Delete(ctx, d.Id())
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrWebhook() *schema.Resource {
@@ -232,7 +234,9 @@ func createWebhook(ctx context.Context, d *schema.ResourceData, scalrClient *sca
 }
 
 func resourceScalrWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupWebhookIntegrations)
 
 	err := createWebhook(ctx, d, scalrClient)
 	if err != nil {
@@ -295,7 +299,7 @@ func readWebhook(ctx context.Context, d *schema.ResourceData, scalrClient *scalr
 }
 
 func resourceScalrWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 
 	if err := readWebhook(ctx, d, scalrClient); err != nil {
 		return diag.FromErr(err)
@@ -375,7 +379,9 @@ func updateWebhook(ctx context.Context, d *schema.ResourceData, scalrClient *sca
 }
 
 func resourceScalrWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupWebhookIntegrations)
 
 	err := updateWebhook(ctx, d, scalrClient)
 	if err != nil {
@@ -386,7 +392,9 @@ func resourceScalrWebhookUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceScalrWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupWebhookIntegrations)
 
 	log.Printf("[DEBUG] Delete webhook: %s", d.Id())
 	err := scalrClient.WebhookIntegrations.Delete(ctx, d.Id())
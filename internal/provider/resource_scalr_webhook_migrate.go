@@ -4,7 +4,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrWebhookResourceV0() *schema.Resource {
@@ -52,7 +53,7 @@ func resourceScalrWebhookResourceV0() *schema.Resource {
 }
 
 func resourceScalrWebhookStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	webhookId := rawState["id"].(string)
 
 	webhook, err := scalrClient.WebhookIntegrations.Read(ctx, webhookId)
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func resourceScalrWorkspaceRunSchedule() *schema.Resource {
@@ -50,7 +52,9 @@ func resourceScalrWorkspaceRunSchedule() *schema.Resource {
 }
 
 func resourceScalrWorkspaceRunScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupWorkspaces)
 
 	workspaceId := d.Get("workspace_id").(string)
 
@@ -91,7 +95,7 @@ func resourceScalrWorkspaceRunScheduleCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceScalrWorkspaceRunScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*framework.Clients).Client
 	workspaceId := d.Id()
 
 	log.Printf("[DEBUG] Read Workspace with ID: %s", workspaceId)
@@ -114,7 +118,9 @@ func resourceScalrWorkspaceRunScheduleRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceScalrWorkspaceRunScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupWorkspaces)
 
 	var err error
 	workspaceId := d.Id()
@@ -155,7 +161,9 @@ func resourceScalrWorkspaceRunScheduleUpdate(ctx context.Context, d *schema.Reso
 }
 
 func resourceScalrWorkspaceRunScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	clients := meta.(*framework.Clients)
+	scalrClient := clients.Client
+	defer clients.LookupCache.Invalidate(framework.LookupWorkspaces)
 
 	log.Printf("[DEBUG] Delete run schedules for workspace: %s", d.Id())
 	_, err := scalrClient.Workspaces.SetSchedule(ctx, d.Id(), scalr.WorkspaceRunScheduleOptions{
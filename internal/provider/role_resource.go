@@ -3,11 +3,14 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 
 	"github.com/scalr/go-scalr/v2/scalr/client"
@@ -21,6 +24,7 @@ var (
 	_ resource.Resource                 = &roleResource{}
 	_ resource.ResourceWithConfigure    = &roleResource{}
 	_ resource.ResourceWithImportState  = &roleResource{}
+	_ resource.ResourceWithModifyPlan   = &roleResource{}
 	_ resource.ResourceWithUpgradeState = &roleResource{}
 )
 
@@ -70,6 +74,13 @@ func roleResourceModelFromAPI(ctx context.Context, role *schemas.Role) (*roleRes
 	return model, diags
 }
 
+// roleAPIErrorPaths maps the API error sources of the role to its attributes.
+var roleAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/name":           path.Root("name"),
+	"/data/attributes/description":    path.Root("description"),
+	"/data/relationships/permissions": path.Root("permissions"),
+}
+
 func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_role"
 }
@@ -79,6 +90,8 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 }
 
 func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupRoles)
+
 	var plan roleResourceModel
 
 	// Read plan data
@@ -108,7 +121,10 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	role, err := r.ClientV2.Role.CreateRole(ctx, &opts, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating role", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error creating role", err,
+			framework.WithAttributePaths(roleAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -150,6 +166,8 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupRoles)
+
 	var plan, state roleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -182,7 +200,10 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Update existing resource
 	role, err := r.ClientV2.Role.UpdateRole(ctx, plan.Id.ValueString(), &opts, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating role", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error updating role", err,
+			framework.WithAttributePaths(roleAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -196,6 +217,8 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupRoles)
+
 	var state roleResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -204,9 +227,79 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	err := r.ClientV2.Role.DeleteRole(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, client.ErrNotFound) {
-		resp.Diagnostics.AddError("Error deleting role", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting role", err)...)
+		return
+	}
+}
+
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.ClientV2 == nil {
+		// Nothing to validate on destroy or before the provider is configured.
 		return
 	}
+
+	var planPermissions types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &planPermissions)...)
+	if resp.Diagnostics.HasError() || planPermissions.IsUnknown() || planPermissions.IsNull() {
+		return
+	}
+
+	// Elements may be unknown until apply, e.g. when built from other resources; only the known ones are validated.
+	var permissions []types.String
+	resp.Diagnostics.Append(planPermissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalog, err := getPermissionCatalog(ctx, r.ClientV2, r.LookupCache)
+	if err != nil {
+		// The permissions are validated by the API on apply anyway.
+		tflog.Warn(ctx, "Unable to retrieve the permission catalog, skipping plan-time validation", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	for _, permission := range permissions {
+		if permission.IsUnknown() || permission.IsNull() {
+			continue
+		}
+		id := permission.ValueString()
+
+		// Deprecation and wildcard matching are inferred from the catalog, so they only produce warnings.
+		switch {
+		case catalog.isListed(id):
+			if catalog.isDeprecated(id) {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("permissions"),
+					"Deprecated permission",
+					fmt.Sprintf("Permission %q is deprecated.%s", id, permissionSuggestionHint(catalog.suggest(id))),
+				)
+			}
+		case isWildcardPermission(id):
+			if !catalog.isKnown(id) {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("permissions"),
+					"Unrecognized permission",
+					fmt.Sprintf("Permission %q does not match any known permission.%s", id, permissionSuggestionHint(nil)),
+				)
+			}
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("permissions"),
+				"Invalid permission",
+				fmt.Sprintf("Permission %q does not exist.%s", id, permissionSuggestionHint(catalog.suggest(id))),
+			)
+		}
+	}
+}
+
+// permissionSuggestionHint formats the suggested permissions for a diagnostic detail.
+func permissionSuggestionHint(suggestions []string) string {
+	if len(suggestions) == 0 {
+		return " Use the scalr_permissions data source to list the available permissions."
+	}
+	return fmt.Sprintf(" Did you mean %s?", strings.Join(suggestions, ", "))
 }
 
 func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
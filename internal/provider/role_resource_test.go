@@ -83,6 +83,31 @@ func TestAccScalrRoleResource_validation(t *testing.T) {
 				PlanOnly:    true,
 				ExpectError: regexp.MustCompile("must not be empty"),
 			},
+			{
+				Config:      testAccScalrRoleWithInvalidPermission(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Permission "workspaces:raed" does not exist. Did you mean workspaces:read`),
+			},
+		},
+	})
+}
+
+func TestAccScalrRoleResource_unknownPermission(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrRoleWithUnknownPermission(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrRoleExists("scalr_role.test"),
+					resource.TestCheckTypeSetElemAttr("scalr_role.test", "permissions.*", "*:read"),
+					resource.TestCheckTypeSetElemAttr("scalr_role.test", "permissions.*", "*:update"),
+				),
+			},
 		},
 	})
 }
@@ -186,3 +211,25 @@ resource "scalr_role" "test" {
   ]
 }`, name)
 }
+
+func testAccScalrRoleWithInvalidPermission(name string) string {
+	return fmt.Sprintf(`
+resource "scalr_role" "test" {
+  name        = "%s"
+  permissions = ["workspaces:raed", "*:update"]
+}`, name)
+}
+
+// testAccScalrRoleWithUnknownPermission builds a permission that is only known after the base role is created.
+func testAccScalrRoleWithUnknownPermission(name string) string {
+	return fmt.Sprintf(`
+resource "scalr_role" "base" {
+  name        = "%[1]s-base"
+  permissions = ["*:read"]
+}
+
+resource "scalr_role" "test" {
+  name        = "%[1]s"
+  permissions = ["*:read", "${substr(scalr_role.base.id, 0, 0)}*:update"]
+}`, name)
+}
@@ -46,9 +46,10 @@ func roleResourceSchema() *schema.Schema {
 				Optional:            true,
 			},
 			"permissions": schema.SetAttribute{
-				MarkdownDescription: "Array of permission names.",
-				Required:            true,
-				ElementType:         types.StringType,
+				MarkdownDescription: "Array of permission names. Unknown permissions are rejected during plan and deprecated ones produce a warning," +
+					" use the `scalr_permissions` data source to list the available ones.",
+				Required:    true,
+				ElementType: types.StringType,
 				Validators: []validator.Set{
 					setvalidator.SizeBetween(1, 128),
 					setvalidator.ValueStringsAre(stringvalidation.StringIsNotWhiteSpace()),
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/ops/role"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/defaults"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource              = &rolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &rolesDataSource{}
+)
+
+func newRolesDataSource() datasource.DataSource {
+	return &rolesDataSource{}
+}
+
+// rolesDataSource defines the data source implementation.
+type rolesDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// rolesDataSourceModel describes the data source data model.
+type rolesDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	AccountID types.String `tfsdk:"account_id"`
+	Name      types.String `tfsdk:"name"`
+	IsSystem  types.Bool   `tfsdk:"is_system"`
+	IDs       types.Set    `tfsdk:"ids"`
+	Roles     types.List   `tfsdk:"roles"`
+}
+
+// roleSummaryModel describes a single role in the list.
+type roleSummaryModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsSystem    types.Bool   `tfsdk:"is_system"`
+	Permissions types.List   `tfsdk:"permissions"`
+}
+
+var roleSummaryElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"is_system":   types.BoolType,
+		"permissions": types.ListType{ElemType: types.StringType},
+	},
+}
+
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *rolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a list of the account and system roles, optionally filtered by name and type.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of this data source.",
+				Computed:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Scalr account, in the format `acc-<RANDOM STRING>`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return roles whose name contains this string, case-insensitive.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"is_system": schema.BoolAttribute{
+				MarkdownDescription: "If set, only return system (`true`) or account (`false`) roles.",
+				Optional:            true,
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "The list of role IDs, in the format [`role-xxxxxxxxxxx`, `role-yyyyyyyyy`].",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"roles": schema.ListAttribute{
+				MarkdownDescription: "The list of matching roles, sorted by name. Each role has the `id`, `name`, `description`," +
+					" `is_system` and the `permissions` it grants.",
+				ElementType: roleSummaryElementType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *rolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg rolesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var accID string
+	if !cfg.AccountID.IsNull() {
+		accID = cfg.AccountID.ValueString()
+	} else {
+		var diags diag.Diagnostics
+		accID, diags = defaults.GetDefaultScalrAccountID()
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := strings.Builder{} // holds the string to build a unique resource id hash
+	id.WriteString(accID)
+	id.WriteString(cfg.Name.ValueString())
+	if !cfg.IsSystem.IsNull() {
+		id.WriteString(cfg.IsSystem.String())
+	}
+
+	opts := &role.GetRolesOptions{
+		// System roles don't belong to any account.
+		Filter:   map[string]string{"account": "in:null," + accID},
+		Include:  []string{"permissions"},
+		PageSize: 100,
+	}
+
+	roles := make([]roleSummaryModel, 0)
+	for r, err := range d.ClientV2.Role.GetRolesIter(ctx, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving roles", err.Error())
+			return
+		}
+
+		if !cfg.Name.IsNull() && !containsFold(r.Attributes.Name, cfg.Name.ValueString()) {
+			continue
+		}
+		if !cfg.IsSystem.IsNull() && cfg.IsSystem.ValueBool() != r.Attributes.IsSystem {
+			continue
+		}
+
+		permissions := make([]string, 0, len(r.Relationships.Permissions))
+		for _, p := range r.Relationships.Permissions {
+			if p != nil {
+				permissions = append(permissions, p.ID)
+			}
+		}
+		sort.Strings(permissions)
+		permissionsValue, diags := types.ListValueFrom(ctx, types.StringType, permissions)
+		resp.Diagnostics.Append(diags...)
+
+		roles = append(roles, roleSummaryModel{
+			Id:          types.StringValue(r.ID),
+			Name:        types.StringValue(r.Attributes.Name),
+			Description: types.StringPointerValue(r.Attributes.Description),
+			IsSystem:    types.BoolValue(r.Attributes.IsSystem),
+			Permissions: permissionsValue,
+		})
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name.ValueString() < roles[j].Name.ValueString()
+	})
+
+	ids := make([]string, len(roles))
+	for i, r := range roles {
+		ids[i] = r.Id.ValueString()
+	}
+
+	cfg.Id = types.StringValue(fmt.Sprintf("%d", framework.HashString(id.String())))
+	cfg.AccountID = types.StringValue(accID)
+
+	idsValue, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	cfg.IDs = idsValue
+
+	rolesValue, diags := types.ListValueFrom(ctx, roleSummaryElementType, roles)
+	resp.Diagnostics.Append(diags...)
+	cfg.Roles = rolesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrRolesDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrRolesDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_roles.custom", "id"),
+					resource.TestCheckResourceAttr("data.scalr_roles.custom", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_roles.custom", "roles.0.id",
+						"scalr_role.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.scalr_roles.custom", "roles.0.is_system", "false"),
+					resource.TestCheckResourceAttr("data.scalr_roles.custom", "roles.0.permissions.#", "2"),
+					resource.TestCheckResourceAttrSet("data.scalr_roles.system", "roles.0.id"),
+					resource.TestCheckResourceAttr("data.scalr_roles.system", "roles.0.is_system", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScalrRolesDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource scalr_role test {
+  name        = "test-roles-%d"
+  account_id  = "%s"
+  permissions = ["workspaces:read", "environments:read"]
+}
+
+data scalr_roles custom {
+  name       = scalr_role.test.name
+  is_system  = false
+  account_id = "%[2]s"
+}
+
+data scalr_roles system {
+  is_system  = true
+  account_id = "%[2]s"
+}`, rInt, defaultAccount)
+}
@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/ops/run"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource              = &runDataSource{}
+	_ datasource.DataSourceWithConfigure = &runDataSource{}
+)
+
+func newRunDataSource() datasource.DataSource {
+	return &runDataSource{}
+}
+
+// runDataSource defines the data source implementation.
+type runDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// runDataSourceModel describes the data source data model.
+type runDataSourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	WorkspaceID      types.String `tfsdk:"workspace_id"`
+	EnvironmentID    types.String `tfsdk:"environment_id"`
+	Status           types.String `tfsdk:"status"`
+	Source           types.String `tfsdk:"source"`
+	Message          types.String `tfsdk:"message"`
+	ErrorMessage     types.String `tfsdk:"error_message"`
+	IsDestroy        types.Bool   `tfsdk:"is_destroy"`
+	IsDry            types.Bool   `tfsdk:"is_dry"`
+	HasChanges       types.Bool   `tfsdk:"has_changes"`
+	CommitSha        types.String `tfsdk:"commit_sha"`
+	Author           types.String `tfsdk:"author"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	StatusTimestamps types.Map    `tfsdk:"status_timestamps"`
+	Plan             types.List   `tfsdk:"plan"`
+	CostEstimate     types.List   `tfsdk:"cost_estimate"`
+	PolicyChecks     types.List   `tfsdk:"policy_checks"`
+}
+
+type runPlanModel struct {
+	Status               types.String `tfsdk:"status"`
+	HasChanges           types.Bool   `tfsdk:"has_changes"`
+	ResourceAdditions    types.Int64  `tfsdk:"resource_additions"`
+	ResourceChanges      types.Int64  `tfsdk:"resource_changes"`
+	ResourceDestructions types.Int64  `tfsdk:"resource_destructions"`
+	ResourcesToAdd       types.List   `tfsdk:"resources_to_add"`
+	ResourcesToChange    types.List   `tfsdk:"resources_to_change"`
+	ResourcesToDestroy   types.List   `tfsdk:"resources_to_destroy"`
+}
+
+var runPlanElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"status":                types.StringType,
+		"has_changes":           types.BoolType,
+		"resource_additions":    types.Int64Type,
+		"resource_changes":      types.Int64Type,
+		"resource_destructions": types.Int64Type,
+		"resources_to_add":      types.ListType{ElemType: types.StringType},
+		"resources_to_change":   types.ListType{ElemType: types.StringType},
+		"resources_to_destroy":  types.ListType{ElemType: types.StringType},
+	},
+}
+
+type runCostEstimateModel struct {
+	Status                  types.String `tfsdk:"status"`
+	PriorMonthlyCost        types.String `tfsdk:"prior_monthly_cost"`
+	ProposedMonthlyCost     types.String `tfsdk:"proposed_monthly_cost"`
+	DeltaMonthlyCost        types.String `tfsdk:"delta_monthly_cost"`
+	ResourcesCount          types.Int64  `tfsdk:"resources_count"`
+	MatchedResourcesCount   types.Int64  `tfsdk:"matched_resources_count"`
+	UnmatchedResourcesCount types.Int64  `tfsdk:"unmatched_resources_count"`
+	ErrorMessage            types.String `tfsdk:"error_message"`
+}
+
+var runCostEstimateElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"status":                    types.StringType,
+		"prior_monthly_cost":        types.StringType,
+		"proposed_monthly_cost":     types.StringType,
+		"delta_monthly_cost":        types.StringType,
+		"resources_count":           types.Int64Type,
+		"matched_resources_count":   types.Int64Type,
+		"unmatched_resources_count": types.Int64Type,
+		"error_message":             types.StringType,
+	},
+}
+
+type runPolicyCheckModel struct {
+	Id             types.String `tfsdk:"id"`
+	Status         types.String `tfsdk:"status"`
+	Passed         types.Int64  `tfsdk:"passed"`
+	AdvisoryFailed types.Int64  `tfsdk:"advisory_failed"`
+	SoftFailed     types.Int64  `tfsdk:"soft_failed"`
+	HardFailed     types.Int64  `tfsdk:"hard_failed"`
+}
+
+var runPolicyCheckElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":              types.StringType,
+		"status":          types.StringType,
+		"passed":          types.Int64Type,
+		"advisory_failed": types.Int64Type,
+		"soft_failed":     types.Int64Type,
+		"hard_failed":     types.Int64Type,
+	},
+}
+
+// planJSON is the part of the Terraform JSON plan representation that holds the resource changes.
+type planJSON struct {
+	ResourceDrift   []planResourceChange `json:"resource_drift"`
+	ResourceChanges []planResourceChange `json:"resource_changes"`
+}
+
+type planResourceChange struct {
+	Address string `json:"address"`
+	Change  struct {
+		Actions []string `json:"actions"`
+	} `json:"change"`
+}
+
+func (d *runDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run"
+}
+
+func (d *runDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the details of a run, including the plan summary, the cost estimate" +
+			" and the results of the policy checks.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the run, in the format `run-<RANDOM STRING>`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace, in the format `ws-<RANDOM STRING>`.",
+				Computed:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment, in the format `env-<RANDOM STRING>`.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current status of the run, e.g. `planned`, `applied`, `errored`.",
+				Computed:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "The source of the run, e.g. `vcs`, `api`, `run-trigger`.",
+				Computed:            true,
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Message describing how the run was triggered.",
+				Computed:            true,
+			},
+			"error_message": schema.StringAttribute{
+				MarkdownDescription: "The reason of the failure if the run has errored.",
+				Computed:            true,
+			},
+			"is_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Boolean indicates if this is a \"destroy\" run.",
+				Computed:            true,
+			},
+			"is_dry": schema.BoolAttribute{
+				MarkdownDescription: "Boolean indicates if this is a dry run. No apply phase if this is true.",
+				Computed:            true,
+			},
+			"has_changes": schema.BoolAttribute{
+				MarkdownDescription: "Boolean indicates if the run has planned any changes.",
+				Computed:            true,
+			},
+			"commit_sha": schema.StringAttribute{
+				MarkdownDescription: "SHA of the commit the run was triggered for, if the run originates from VCS.",
+				Computed:            true,
+			},
+			"author": schema.StringAttribute{
+				MarkdownDescription: "Username of the commit author in the VCS, or of the user that triggered the run.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the run was created, in RFC3339 format.",
+				Computed:            true,
+			},
+			"status_timestamps": schema.MapAttribute{
+				MarkdownDescription: "The times of transition to each status, e.g. `planned-at`, `applied-at`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"plan": schema.ListAttribute{
+				MarkdownDescription: "The plan summary: the `status` of the plan phase, `has_changes`, the number of" +
+					" `resource_additions`, `resource_changes` and `resource_destructions`, and the addresses of the resources" +
+					" in `resources_to_add`, `resources_to_change` and `resources_to_destroy`. A replaced resource is listed" +
+					" both to add and to destroy. Empty if the plan phase has not started.",
+				ElementType: runPlanElementType,
+				Computed:    true,
+			},
+			"cost_estimate": schema.ListAttribute{
+				MarkdownDescription: "The cost estimate reported by the Infracost integration: the `status`, the" +
+					" `prior_monthly_cost`, `proposed_monthly_cost` and `delta_monthly_cost`, the number of resources" +
+					" (`resources_count`, `matched_resources_count`, `unmatched_resources_count`) and the `error_message`." +
+					" Empty if cost estimation is not enabled for the run.",
+				ElementType: runCostEstimateElementType,
+				Computed:    true,
+			},
+			"policy_checks": schema.ListAttribute{
+				MarkdownDescription: "The results of the OPA policy checks. Each check has the `id`, the `status` and the number of" +
+					" `passed`, `advisory_failed`, `soft_failed` and `hard_failed` policies." +
+					" The API does not report the policy group or the result and enforcement level of each policy for a run," +
+					" so only these counts are available.",
+				ElementType: runPolicyCheckElementType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *runDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg runDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &run.GetRunOptions{
+		Include: []string{"plan", "vcs-revision", "created-by", "cost-estimate", "policy-checks"},
+	}
+	r, err := d.ClientV2.Run.GetRun(ctx, cfg.Id.ValueString(), opts)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddError("Error retrieving run", fmt.Sprintf("Could not find run %s.", cfg.Id.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving run", err.Error())
+		return
+	}
+
+	summary, diags := runSummaryModelFromAPI(ctx, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg.WorkspaceID = summary.WorkspaceID
+	cfg.EnvironmentID = types.StringNull()
+	if r.Relationships.Environment != nil {
+		cfg.EnvironmentID = types.StringValue(r.Relationships.Environment.ID)
+	}
+	cfg.Status = summary.Status
+	cfg.Source = summary.Source
+	cfg.Message = summary.Message
+	cfg.ErrorMessage = types.StringPointerValue(r.Attributes.ErrorMessage)
+	cfg.IsDestroy = summary.IsDestroy
+	cfg.IsDry = summary.IsDry
+	cfg.HasChanges = types.BoolValue(r.Attributes.HasChanges)
+	cfg.CommitSha = summary.CommitSha
+	cfg.Author = summary.Author
+	cfg.CreatedAt = summary.CreatedAt
+	cfg.StatusTimestamps = summary.StatusTimestamps
+
+	var plans []runPlanModel
+	if r.Relationships.Plan != nil {
+		plan, diags := d.readPlan(ctx, r.Relationships.Plan)
+		resp.Diagnostics.Append(diags...)
+		plans = append(plans, *plan)
+	}
+	planValue, diags := types.ListValueFrom(ctx, runPlanElementType, plans)
+	resp.Diagnostics.Append(diags...)
+	cfg.Plan = planValue
+
+	var costEstimates []runCostEstimateModel
+	if ce := r.Relationships.CostEstimate; ce != nil {
+		costEstimates = append(costEstimates, runCostEstimateModel{
+			Status:                  types.StringValue(string(ce.Attributes.Status)),
+			PriorMonthlyCost:        types.StringValue(ce.Attributes.PriorMonthlyCost),
+			ProposedMonthlyCost:     types.StringValue(ce.Attributes.ProposedMonthlyCost),
+			DeltaMonthlyCost:        types.StringValue(ce.Attributes.DeltaMonthlyCost),
+			ResourcesCount:          int64PointerValue(ce.Attributes.ResourcesCount),
+			MatchedResourcesCount:   int64PointerValue(ce.Attributes.MatchedResourcesCount),
+			UnmatchedResourcesCount: int64PointerValue(ce.Attributes.UnmatchedResourcesCount),
+			ErrorMessage:            types.StringPointerValue(ce.Attributes.ErrorMessage),
+		})
+	}
+	costEstimateValue, diags := types.ListValueFrom(ctx, runCostEstimateElementType, costEstimates)
+	resp.Diagnostics.Append(diags...)
+	cfg.CostEstimate = costEstimateValue
+
+	policyChecks := make([]runPolicyCheckModel, 0, len(r.Relationships.PolicyChecks))
+	for _, pc := range r.Relationships.PolicyChecks {
+		if pc == nil {
+			continue
+		}
+
+		policyChecks = append(policyChecks, runPolicyCheckModel{
+			Id:             types.StringValue(pc.ID),
+			Status:         types.StringValue(string(pc.Attributes.Status)),
+			Passed:         types.Int64Value(int64(pc.Attributes.Result.Passed)),
+			AdvisoryFailed: types.Int64Value(int64(pc.Attributes.Result.AdvisoryFailed)),
+			SoftFailed:     types.Int64Value(int64(pc.Attributes.Result.SoftFailed)),
+			HardFailed:     types.Int64Value(int64(pc.Attributes.Result.HardFailed)),
+		})
+	}
+	policyChecksValue, diags := types.ListValueFrom(ctx, runPolicyCheckElementType, policyChecks)
+	resp.Diagnostics.Append(diags...)
+	cfg.PolicyChecks = policyChecksValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// readPlan builds the plan summary. The resource addresses are taken from the
+// sanitized JSON plan, so the summary doesn't depend on the access to sensitive values.
+func (d *runDataSource) readPlan(ctx context.Context, p *schemas.Plan) (*runPlanModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	plan := &runPlanModel{
+		Status:               types.StringValue(string(p.Attributes.Status)),
+		HasChanges:           types.BoolValue(p.Attributes.HasChanges),
+		ResourceAdditions:    int64PointerValue(p.Attributes.ResourceAdditions),
+		ResourceChanges:      int64PointerValue(p.Attributes.ResourceChanges),
+		ResourceDestructions: int64PointerValue(p.Attributes.ResourceDestructions),
+		ResourcesToAdd:       types.ListNull(types.StringType),
+		ResourcesToChange:    types.ListNull(types.StringType),
+		ResourcesToDestroy:   types.ListNull(types.StringType),
+	}
+
+	if p.Attributes.Status != schemas.PlanStatusFinished {
+		return plan, diags
+	}
+
+	raw, err := d.ClientV2.Plan.GetSanitizedJsonOutput(ctx, p.ID, nil)
+	if err != nil {
+		diags.AddWarning(
+			"Error retrieving plan output",
+			fmt.Sprintf("Could not read the JSON output of plan %s, resource addresses are not available: %v", p.ID, err),
+		)
+		return plan, diags
+	}
+
+	var out planJSON
+	if err = json.Unmarshal([]byte(raw), &out); err != nil {
+		diags.AddWarning(
+			"Error decoding plan output",
+			fmt.Sprintf("Could not decode the JSON output of plan %s, resource addresses are not available: %v", p.ID, err),
+		)
+		return plan, diags
+	}
+
+	toAdd, toChange, toDestroy := make([]string, 0), make([]string, 0), make([]string, 0)
+	for _, rc := range out.ResourceChanges {
+		for _, action := range rc.Change.Actions {
+			switch action {
+			case "create":
+				toAdd = append(toAdd, rc.Address)
+			case "update":
+				toChange = append(toChange, rc.Address)
+			case "delete":
+				toDestroy = append(toDestroy, rc.Address)
+			}
+		}
+	}
+
+	var listDiags diag.Diagnostics
+	plan.ResourcesToAdd, listDiags = types.ListValueFrom(ctx, types.StringType, toAdd)
+	diags.Append(listDiags...)
+	plan.ResourcesToChange, listDiags = types.ListValueFrom(ctx, types.StringType, toChange)
+	diags.Append(listDiags...)
+	plan.ResourcesToDestroy, listDiags = types.ListValueFrom(ctx, types.StringType, toDestroy)
+	diags.Append(listDiags...)
+
+	return plan, diags
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scalr/go-scalr"
+	"github.com/scalr/go-scalr/v2/scalr/ops/run"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+)
+
+func TestAccScalrRunDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories:  protoV5ProviderFactories(t),
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config:      `data scalr_run test { id = "run-123" }`,
+				ExpectError: regexp.MustCompile("Could not find run run-123"),
+				PlanOnly:    true,
+			},
+			{
+				Config: testAccCurrentRunInitConfig(rInt),
+			},
+			{
+				PreConfig: launchRun(fmt.Sprintf("test-env-%d", rInt), fmt.Sprintf("test-ws-%d", rInt)),
+				Config:    testAccScalrRunDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_run.test", "id",
+						"data.scalr_current_run.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_run.test", "workspace_id",
+						"scalr_workspace.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_run.test", "environment_id",
+						"scalr_environment.test", "id",
+					),
+					resource.TestCheckResourceAttrSet("data.scalr_run.test", "status"),
+					resource.TestCheckResourceAttrSet("data.scalr_run.test", "created_at"),
+					resource.TestCheckResourceAttr("data.scalr_run.test", "is_destroy", "false"),
+					resource.TestCheckResourceAttr("data.scalr_run.test", "policy_checks.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccScalrRunDataSource_policyCheck(t *testing.T) {
+	rInt := GetRandomInteger()
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "main.tf"), []byte(`
+resource "terraform_data" "first" {}
+resource "terraform_data" "second" {}
+`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var workspaceID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			// TODO: delete skip after SCALRCORE-19891
+			t.Skip("Works with personal token but does not work with github action token.")
+			testVcsAccGithubTokenPreCheck(t)
+		},
+		ProtoV5ProviderFactories:  protoV5ProviderFactories(t),
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrRunDataSourcePolicyCheckConfig(rInt, dir),
+				Check: func(s *terraform.State) error {
+					workspaceID = s.RootModule().Resources["scalr_workspace.test"].Primary.ID
+					return nil
+				},
+			},
+			{
+				PreConfig: func() { waitForRunPolicyCheck(t, workspaceID) },
+				Config: testAccScalrRunDataSourcePolicyCheckConfig(rInt, dir) + `
+
+data scalr_runs test {
+  workspace_id = scalr_workspace.test.id
+  max_results  = 1
+}
+
+data scalr_run test {
+  id = data.scalr_runs.test.runs[0].id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scalr_run.test", "plan.#", "1"),
+					resource.TestCheckResourceAttr("data.scalr_run.test", "plan.0.resource_additions", "2"),
+					resource.TestCheckResourceAttr("data.scalr_run.test", "plan.0.resources_to_add.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.scalr_run.test", "plan.0.resources_to_add.*", "terraform_data.first"),
+					resource.TestCheckTypeSetElemAttr("data.scalr_run.test", "plan.0.resources_to_add.*", "terraform_data.second"),
+					resource.TestCheckResourceAttr("data.scalr_run.test", "plan.0.resources_to_change.#", "0"),
+					resource.TestCheckResourceAttr("data.scalr_run.test", "plan.0.resources_to_destroy.#", "0"),
+					resource.TestCheckResourceAttr("data.scalr_run.test", "policy_checks.#", "1"),
+					resource.TestCheckResourceAttrSet("data.scalr_run.test", "policy_checks.0.id"),
+					resource.TestCheckResourceAttrSet("data.scalr_run.test", "policy_checks.0.status"),
+					resource.TestCheckResourceAttrSet("data.scalr_run.test", "policy_checks.0.passed"),
+					resource.TestCheckResourceAttrSet("data.scalr_run.test", "policy_checks.0.advisory_failed"),
+					resource.TestCheckResourceAttrSet("data.scalr_run.test", "policy_checks.0.soft_failed"),
+					resource.TestCheckResourceAttrSet("data.scalr_run.test", "policy_checks.0.hard_failed"),
+				),
+			},
+		},
+	})
+}
+
+// waitForRunPolicyCheck waits until a policy check of the latest run in the workspace has finished.
+func waitForRunPolicyCheck(t *testing.T, workspaceID string) {
+	t.Helper()
+
+	scalrClient := createScalrClientV2()
+	deadline := time.Now().Add(10 * time.Minute)
+	for time.Now().Before(deadline) {
+		opts := &run.GetRunsOptions{
+			Filter:  map[string]string{"workspace": workspaceID},
+			Include: []string{"policy-checks"},
+		}
+		for r, err := range scalrClient.Run.GetRunsIter(ctx, opts) {
+			if err != nil {
+				t.Fatalf("Error retrieving runs: %v", err)
+			}
+			for _, pc := range r.Relationships.PolicyChecks {
+				switch pc.Attributes.Status {
+				case schemas.PolicyCheckStatusPending, schemas.PolicyCheckStatusQueued:
+				default:
+					return
+				}
+			}
+		}
+		time.Sleep(5 * time.Second)
+	}
+	t.Fatalf("Timed out waiting for the policy check of a run in workspace %s", workspaceID)
+}
+
+func testAccScalrRunDataSourcePolicyCheckConfig(rInt int, dir string) string {
+	return fmt.Sprintf(`
+resource scalr_environment test {
+  name       = "test-env-%[1]d"
+  account_id = "%[2]s"
+}
+
+resource scalr_vcs_provider test {
+  name     = "test-github-%[1]d"
+  vcs_type = "%[3]s"
+  token    = "%[4]s"
+}
+
+resource scalr_policy_group test {
+  name            = "test-pg-%[1]d"
+  account_id      = "%[2]s"
+  vcs_provider_id = scalr_vcs_provider.test.id
+  vcs_repo {
+    identifier = "%[5]s"
+    path       = "%[6]s"
+  }
+}
+
+resource scalr_policy_group_linkage test {
+  policy_group_id = scalr_policy_group.test.id
+  environment_id  = scalr_environment.test.id
+}
+
+resource scalr_workspace test {
+  name           = "test-ws-%[1]d"
+  environment_id = scalr_environment.test.id
+  auto_apply     = false
+}
+
+resource scalr_configuration_version test {
+  workspace_id    = scalr_workspace.test.id
+  directory       = %[7]q
+  auto_queue_runs = true
+  depends_on      = [scalr_policy_group_linkage.test]
+}`, rInt, defaultAccount, string(scalr.Github), githubToken, policyGroupVcsRepoID, policyGroupVcsRepoPath, dir)
+}
+
+func testAccScalrRunDataSourceConfig(rInt int) string {
+	return testAccCurrentRunDataSourceConfig(rInt) + `
+
+data scalr_run test {
+  id = data.scalr_current_run.test.id
+}`
+}
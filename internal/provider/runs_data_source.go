@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/ops/run"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource                     = &runsDataSource{}
+	_ datasource.DataSourceWithConfigure        = &runsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &runsDataSource{}
+)
+
+func newRunsDataSource() datasource.DataSource {
+	return &runsDataSource{}
+}
+
+// runsDataSource defines the data source implementation.
+type runsDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// runsDataSourceModel describes the data source data model.
+type runsDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	WorkspaceID   types.String `tfsdk:"workspace_id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	Statuses      types.Set    `tfsdk:"statuses"`
+	Sources       types.Set    `tfsdk:"sources"`
+	IsDestroy     types.Bool   `tfsdk:"is_destroy"`
+	CreatedAfter  types.String `tfsdk:"created_after"`
+	CreatedBefore types.String `tfsdk:"created_before"`
+	MaxResults    types.Int64  `tfsdk:"max_results"`
+	Runs          types.List   `tfsdk:"runs"`
+}
+
+// runSummaryModel describes a single run in the list.
+type runSummaryModel struct {
+	Id                   types.String `tfsdk:"id"`
+	WorkspaceID          types.String `tfsdk:"workspace_id"`
+	Status               types.String `tfsdk:"status"`
+	Source               types.String `tfsdk:"source"`
+	Message              types.String `tfsdk:"message"`
+	IsDestroy            types.Bool   `tfsdk:"is_destroy"`
+	IsDry                types.Bool   `tfsdk:"is_dry"`
+	CommitSha            types.String `tfsdk:"commit_sha"`
+	Author               types.String `tfsdk:"author"`
+	ResourceAdditions    types.Int64  `tfsdk:"resource_additions"`
+	ResourceChanges      types.Int64  `tfsdk:"resource_changes"`
+	ResourceDestructions types.Int64  `tfsdk:"resource_destructions"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	StatusTimestamps     types.Map    `tfsdk:"status_timestamps"`
+}
+
+var runSummaryElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                    types.StringType,
+		"workspace_id":          types.StringType,
+		"status":                types.StringType,
+		"source":                types.StringType,
+		"message":               types.StringType,
+		"is_destroy":            types.BoolType,
+		"is_dry":                types.BoolType,
+		"commit_sha":            types.StringType,
+		"author":                types.StringType,
+		"resource_additions":    types.Int64Type,
+		"resource_changes":      types.Int64Type,
+		"resource_destructions": types.Int64Type,
+		"created_at":            types.StringType,
+		"status_timestamps":     types.MapType{ElemType: types.StringType},
+	},
+}
+
+func runSummaryModelFromAPI(ctx context.Context, r *schemas.Run) (*runSummaryModel, diag.Diagnostics) {
+	model := &runSummaryModel{
+		Id:                   types.StringValue(r.ID),
+		WorkspaceID:          types.StringNull(),
+		Status:               types.StringValue(string(r.Attributes.Status)),
+		Source:               types.StringValue(runSource(r)),
+		Message:              types.StringPointerValue(r.Attributes.Message),
+		IsDestroy:            types.BoolValue(r.Attributes.IsDestroy),
+		IsDry:                types.BoolValue(r.Attributes.IsDry),
+		CommitSha:            types.StringNull(),
+		Author:               types.StringNull(),
+		ResourceAdditions:    types.Int64Null(),
+		ResourceChanges:      types.Int64Null(),
+		ResourceDestructions: types.Int64Null(),
+		CreatedAt:            types.StringValue(r.Attributes.CreatedAt.Format(time.RFC3339)),
+	}
+
+	if r.Relationships.Workspace != nil {
+		model.WorkspaceID = types.StringValue(r.Relationships.Workspace.ID)
+	}
+
+	if r.Relationships.VcsRevision != nil {
+		model.CommitSha = types.StringPointerValue(r.Relationships.VcsRevision.Attributes.CommitSha)
+		model.Author = types.StringPointerValue(r.Relationships.VcsRevision.Attributes.SenderUsername)
+	}
+	if model.Author.IsNull() && r.Relationships.CreatedBy != nil {
+		model.Author = types.StringValue(r.Relationships.CreatedBy.Attributes.Username)
+	}
+
+	if p := r.Relationships.Plan; p != nil {
+		model.ResourceAdditions = int64PointerValue(p.Attributes.ResourceAdditions)
+		model.ResourceChanges = int64PointerValue(p.Attributes.ResourceChanges)
+		model.ResourceDestructions = int64PointerValue(p.Attributes.ResourceDestructions)
+	}
+
+	timestamps := make(map[string]string, len(r.Attributes.StatusTimestamps))
+	for k, v := range r.Attributes.StatusTimestamps {
+		if s, ok := v.(string); ok {
+			timestamps[k] = s
+		}
+	}
+	timestampsValue, diags := types.MapValueFrom(ctx, types.StringType, timestamps)
+	model.StatusTimestamps = timestampsValue
+
+	return model, diags
+}
+
+// runSource returns the origin of the run as a string.
+func runSource(r *schemas.Run) string {
+	if r.Attributes.Source == nil {
+		return ""
+	}
+	return fmt.Sprint(r.Attributes.Source)
+}
+
+// inFilter builds the value of a list filter that matches any of the values.
+func inFilter(values []string) string {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return "in:" + strings.Join(values, ",")
+}
+
+func int64PointerValue(v *int) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
+
+func (d *runsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runs"
+}
+
+func (d *runsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a list of runs in a workspace or an environment, optionally filtered by status, source, type and creation time.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of this data source.",
+				Computed:            true,
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace to list runs in, in the format `ws-<RANDOM STRING>`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment to list runs in, in the format `env-<RANDOM STRING>`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"statuses": schema.SetAttribute{
+				MarkdownDescription: "Only return runs in one of these statuses, e.g. `applied`, `errored`, `planned_and_finished`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidation.StringIsNotWhiteSpace()),
+				},
+			},
+			"sources": schema.SetAttribute{
+				MarkdownDescription: "Only return runs that originate from one of these sources, e.g. `vcs`, `api`, `run-trigger`, `schedule`, `drift`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidation.StringIsNotWhiteSpace()),
+				},
+			},
+			"is_destroy": schema.BoolAttribute{
+				MarkdownDescription: "If set, only return destroy (`true`) or non-destroy (`false`) runs.",
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Only return runs created at or after this time, in RFC3339 format.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsRFC3339(),
+				},
+			},
+			"created_before": schema.StringAttribute{
+				MarkdownDescription: "Only return runs created before this time, in RFC3339 format.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsRFC3339(),
+				},
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of the most recent matching runs to return. By default, all matching runs are returned." +
+					" All pages of matching runs are still read to find the most recent ones, so the limit does not reduce the number of API calls.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"runs": schema.ListAttribute{
+				MarkdownDescription: "The list of matching runs, newest first. Each run has the `id`, `workspace_id`, `status`, `source`, `message`, `is_destroy`," +
+					" `is_dry`, `commit_sha`, `author` (username of the commit author in the VCS, or of the user that triggered the run)," +
+					" `resource_additions`, `resource_changes` and `resource_destructions` planned, `created_at`," +
+					" and `status_timestamps` (times of transition to each status, e.g. `planned-at`, `applied-at`).",
+				ElementType: runSummaryElementType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *runsDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("workspace_id"),
+			path.MatchRoot("environment_id"),
+		),
+	}
+}
+
+func (d *runsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg runsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := strings.Builder{} // holds the string to build a unique resource id hash
+
+	opts := &run.GetRunsOptions{
+		Filter:   map[string]string{},
+		Include:  []string{"plan", "vcs-revision", "created-by"},
+		PageSize: 100,
+	}
+	if !cfg.WorkspaceID.IsNull() {
+		id.WriteString(cfg.WorkspaceID.ValueString())
+		opts.Filter["workspace"] = cfg.WorkspaceID.ValueString()
+	} else {
+		id.WriteString(cfg.EnvironmentID.ValueString())
+		opts.Filter["environment"] = cfg.EnvironmentID.ValueString()
+	}
+
+	statuses := make(map[string]struct{})
+	if !cfg.Statuses.IsNull() {
+		var v []string
+		resp.Diagnostics.Append(cfg.Statuses.ElementsAs(ctx, &v, false)...)
+		for _, s := range v {
+			id.WriteString(s)
+			statuses[s] = struct{}{}
+		}
+		if len(v) > 0 {
+			opts.Filter["status"] = inFilter(v)
+		}
+	}
+
+	sources := make(map[string]struct{})
+	if !cfg.Sources.IsNull() {
+		var v []string
+		resp.Diagnostics.Append(cfg.Sources.ElementsAs(ctx, &v, false)...)
+		for _, s := range v {
+			id.WriteString(s)
+			sources[s] = struct{}{}
+		}
+		if len(v) > 0 {
+			opts.Filter["source"] = inFilter(v)
+		}
+	}
+
+	var createdAfter, createdBefore time.Time
+	if !cfg.CreatedAfter.IsNull() {
+		id.WriteString(cfg.CreatedAfter.ValueString())
+		createdAfter, _ = time.Parse(time.RFC3339, cfg.CreatedAfter.ValueString())
+	}
+	if !cfg.CreatedBefore.IsNull() {
+		id.WriteString(cfg.CreatedBefore.ValueString())
+		createdBefore, _ = time.Parse(time.RFC3339, cfg.CreatedBefore.ValueString())
+	}
+	if !cfg.IsDestroy.IsNull() {
+		id.WriteString(cfg.IsDestroy.String())
+	}
+	if !cfg.MaxResults.IsNull() {
+		id.WriteString(cfg.MaxResults.String())
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var maxResults int64
+	if !cfg.MaxResults.IsNull() {
+		maxResults = cfg.MaxResults.ValueInt64()
+	}
+	matched, err := collectRuns(d.ClientV2.Run.GetRunsIter(ctx, opts), func(r *schemas.Run) bool {
+		if !createdAfter.IsZero() && r.Attributes.CreatedAt.Before(createdAfter) {
+			return false
+		}
+		if !createdBefore.IsZero() && !r.Attributes.CreatedAt.Before(createdBefore) {
+			return false
+		}
+		// The statuses and sources are filtered by the API as well, these checks only guard the result.
+		if len(statuses) > 0 {
+			if _, ok := statuses[string(r.Attributes.Status)]; !ok {
+				return false
+			}
+		}
+		if len(sources) > 0 {
+			if _, ok := sources[runSource(r)]; !ok {
+				return false
+			}
+		}
+		if !cfg.IsDestroy.IsNull() && cfg.IsDestroy.ValueBool() != r.Attributes.IsDestroy {
+			return false
+		}
+		return true
+	}, maxResults)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving runs", err.Error())
+		return
+	}
+
+	runs := make([]runSummaryModel, len(matched))
+	for i := range matched {
+		model, diags := runSummaryModelFromAPI(ctx, &matched[i])
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		runs[i] = *model
+	}
+
+	runsValue, diags := types.ListValueFrom(ctx, runSummaryElementType, runs)
+	resp.Diagnostics.Append(diags...)
+	cfg.Runs = runsValue
+
+	cfg.Id = types.StringValue(fmt.Sprintf("%d", framework.HashString(id.String())))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// collectRuns returns the runs accepted by match, newest first, limited to maxResults if it is positive.
+// The order of the runs returned by the API is not documented, so all pages are read before the limit is applied.
+func collectRuns(runs iter.Seq2[schemas.Run, error], match func(*schemas.Run) bool, maxResults int64) ([]schemas.Run, error) {
+	var matched []schemas.Run
+	for r, err := range runs {
+		if err != nil {
+			return nil, err
+		}
+		if match(&r) {
+			matched = append(matched, r)
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].Attributes.CreatedAt.After(matched[j].Attributes.CreatedAt)
+	})
+	if maxResults > 0 && int64(len(matched)) > maxResults {
+		matched = matched[:maxResults]
+	}
+	return matched, nil
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+)
+
+func TestAccScalrRunsDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      `data scalr_runs test {}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+				PlanOnly:    true,
+			},
+			{
+				Config: testAccScalrRunsDataSourceConfig(rInt, `created_after = "yesterday"`),
+				ExpectError: regexp.MustCompile(
+					"Attribute created_after must be a valid RFC3339 timestamp",
+				),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccScalrRunsDataSourceConfig(rInt, `max_results = 0`),
+				ExpectError: regexp.MustCompile("Attribute max_results value must be at least 1"),
+				PlanOnly:    true,
+			},
+			{
+				Config: testAccScalrRunsDataSourceConfig(rInt, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_runs.test", "id"),
+					resource.TestCheckResourceAttr("data.scalr_runs.test", "runs.#", "0"),
+				),
+			},
+			{
+				Config: testAccScalrRunsDataSourceConfig(rInt, `statuses = ["applied", "errored"]
+  sources = ["vcs"]
+  is_destroy = false
+  created_after = "2020-01-01T00:00:00Z"
+  max_results = 5`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_runs.test", "id"),
+					resource.TestCheckResourceAttr("data.scalr_runs.test", "runs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestInFilter(t *testing.T) {
+	for name, tc := range map[string]struct {
+		values []string
+		want   string
+	}{
+		"single":   {values: []string{"applied"}, want: "applied"},
+		"multiple": {values: []string{"applied", "errored"}, want: "in:applied,errored"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := inFilter(tc.values); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCollectRuns(t *testing.T) {
+	now := time.Now()
+	newRun := func(id string, age time.Duration, isDestroy bool) schemas.Run {
+		r := schemas.Run{ID: id}
+		r.Attributes.CreatedAt = now.Add(-age)
+		r.Attributes.IsDestroy = isDestroy
+		return r
+	}
+	// Two pages in no particular order: the newest runs are on the second page.
+	pages := [][]schemas.Run{
+		{newRun("run-3", 3*time.Hour, false), newRun("run-1", time.Hour, true), newRun("run-5", 5*time.Hour, false)},
+		{newRun("run-2", 2*time.Hour, false), newRun("run-0", 0, false), newRun("run-4", 4*time.Hour, false)},
+	}
+	seq := func(yield func(schemas.Run, error) bool) {
+		for _, page := range pages {
+			for _, r := range page {
+				if !yield(r, nil) {
+					return
+				}
+			}
+		}
+	}
+	ids := func(runs []schemas.Run) []string {
+		result := make([]string, len(runs))
+		for i, r := range runs {
+			result[i] = r.ID
+		}
+		return result
+	}
+	notDestroy := func(r *schemas.Run) bool { return !r.Attributes.IsDestroy }
+
+	runs, err := collectRuns(seq, notDestroy, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := ids(runs), []string{"run-0", "run-2", "run-3", "run-4", "run-5"}; !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	runs, err = collectRuns(seq, notDestroy, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := ids(runs), []string{"run-0", "run-2"}; !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	_, err = collectRuns(func(yield func(schemas.Run, error) bool) {
+		yield(schemas.Run{}, errors.New("boom"))
+	}, notDestroy, 0)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func testAccScalrRunsDataSourceConfig(rInt int, filters string) string {
+	return fmt.Sprintf(`
+resource scalr_environment test {
+  name = "test-env-%d"
+}
+
+resource scalr_workspace test {
+  name           = "test-ws-%[1]d"
+  environment_id = scalr_environment.test.id
+}
+
+data scalr_runs test {
+  workspace_id = scalr_workspace.test.id
+  %[2]s
+}`, rInt, filters)
+}
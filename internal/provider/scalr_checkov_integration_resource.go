@@ -243,7 +243,7 @@ func (r *checkovIntegrationResource) Create(ctx context.Context, req resource.Cr
 
 	checkovIntegration, err := r.Client.CheckovIntegrations.Create(ctx, opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Checkov integration", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error creating Checkov integration", err)...)
 		return
 	}
 
@@ -429,7 +429,7 @@ func (r *checkovIntegrationResource) Update(ctx context.Context, req resource.Up
 	// Update existing resource
 	checkovIntegration, err := r.Client.CheckovIntegrations.Update(ctx, plan.Id.ValueString(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Checkov integration", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error updating Checkov integration", err)...)
 		return
 	}
 
@@ -487,7 +487,7 @@ func (r *checkovIntegrationResource) Delete(ctx context.Context, req resource.De
 
 	err := r.Client.CheckovIntegrations.Delete(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, scalr.ErrResourceNotFound) {
-		resp.Diagnostics.AddError("Error deleting Checkov integration", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting Checkov integration", err)...)
 		return
 	}
 }
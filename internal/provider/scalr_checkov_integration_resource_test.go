@@ -10,6 +10,8 @@ import (
 	"github.com/scalr/go-scalr"
 	"regexp"
 	"testing"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestCheckovIntegrationResource_Create(t *testing.T) {
@@ -130,7 +132,7 @@ resource "scalr_checkov_integration" "test" {
 }
 
 func testAccCheckCheckovIntegrationDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_checkov_integration" {
@@ -157,7 +159,7 @@ func testAccCheckCheckovIntegrationIsShared(resourceName string, expectedIsShare
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 		readKey, err := scalrClient.CheckovIntegrations.Read(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Error reading Infracost Integration: %s", err)
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/ops/service_account"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/defaults"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource              = &serviceAccountsDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceAccountsDataSource{}
+)
+
+func newServiceAccountsDataSource() datasource.DataSource {
+	return &serviceAccountsDataSource{}
+}
+
+// serviceAccountsDataSource defines the data source implementation.
+type serviceAccountsDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// serviceAccountsDataSourceModel describes the data source data model.
+type serviceAccountsDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	AccountID       types.String `tfsdk:"account_id"`
+	Name            types.String `tfsdk:"name"`
+	Email           types.String `tfsdk:"email"`
+	Status          types.String `tfsdk:"status"`
+	IDs             types.Set    `tfsdk:"ids"`
+	ServiceAccounts types.List   `tfsdk:"service_accounts"`
+}
+
+// serviceAccountSummaryModel describes a single service account in the list.
+type serviceAccountSummaryModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Email       types.String `tfsdk:"email"`
+	Description types.String `tfsdk:"description"`
+	Status      types.String `tfsdk:"status"`
+	Owners      types.List   `tfsdk:"owners"`
+}
+
+var serviceAccountSummaryElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"email":       types.StringType,
+		"description": types.StringType,
+		"status":      types.StringType,
+		"owners":      types.ListType{ElemType: types.StringType},
+	},
+}
+
+func (d *serviceAccountsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_accounts"
+}
+
+func (d *serviceAccountsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a list of service accounts in the account, optionally filtered by name, email and status.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of this data source.",
+				Computed:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Scalr account, in the format `acc-<RANDOM STRING>`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return service accounts whose name contains this string, case-insensitive.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Only return service accounts whose email contains this string, case-insensitive.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return service accounts in this status: `Active` or `Inactive`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(schemas.ServiceAccountStatusActive),
+						string(schemas.ServiceAccountStatusInactive),
+					),
+				},
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "The list of service account IDs, in the format [`sa-xxxxxxxxxxx`, `sa-yyyyyyyyy`].",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"service_accounts": schema.ListAttribute{
+				MarkdownDescription: "The list of matching service accounts, sorted by name. Each service account has the `id`, `name`, `email`," +
+					" `description`, `status` and the `owners` team identifiers.",
+				ElementType: serviceAccountSummaryElementType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *serviceAccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg serviceAccountsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var accID string
+	if !cfg.AccountID.IsNull() {
+		accID = cfg.AccountID.ValueString()
+	} else {
+		var diags diag.Diagnostics
+		accID, diags = defaults.GetDefaultScalrAccountID()
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := strings.Builder{} // holds the string to build a unique resource id hash
+	id.WriteString(accID)
+	id.WriteString(cfg.Name.ValueString())
+	id.WriteString(cfg.Email.ValueString())
+	id.WriteString(cfg.Status.ValueString())
+
+	opts := &service_account.GetServiceAccountsOptions{
+		Filter:   map[string]string{"account": accID},
+		PageSize: 100,
+	}
+
+	serviceAccounts := make([]serviceAccountSummaryModel, 0)
+	for sa, err := range d.ClientV2.ServiceAccount.GetServiceAccountsIter(ctx, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving service accounts", err.Error())
+			return
+		}
+
+		if !cfg.Name.IsNull() && !containsFold(sa.Attributes.Name, cfg.Name.ValueString()) {
+			continue
+		}
+		if !cfg.Email.IsNull() && !containsFold(sa.Attributes.Email, cfg.Email.ValueString()) {
+			continue
+		}
+		if !cfg.Status.IsNull() && string(sa.Attributes.Status) != cfg.Status.ValueString() {
+			continue
+		}
+
+		owners := make([]string, 0, len(sa.Relationships.Owners))
+		for _, t := range sa.Relationships.Owners {
+			if t != nil {
+				owners = append(owners, t.ID)
+			}
+		}
+		ownersValue, diags := types.ListValueFrom(ctx, types.StringType, owners)
+		resp.Diagnostics.Append(diags...)
+
+		serviceAccounts = append(serviceAccounts, serviceAccountSummaryModel{
+			Id:          types.StringValue(sa.ID),
+			Name:        types.StringValue(sa.Attributes.Name),
+			Email:       types.StringValue(sa.Attributes.Email),
+			Description: types.StringPointerValue(sa.Attributes.Description),
+			Status:      types.StringValue(string(sa.Attributes.Status)),
+			Owners:      ownersValue,
+		})
+	}
+
+	sort.Slice(serviceAccounts, func(i, j int) bool {
+		return serviceAccounts[i].Name.ValueString() < serviceAccounts[j].Name.ValueString()
+	})
+
+	ids := make([]string, len(serviceAccounts))
+	for i, sa := range serviceAccounts {
+		ids[i] = sa.Id.ValueString()
+	}
+
+	cfg.Id = types.StringValue(fmt.Sprintf("%d", framework.HashString(id.String())))
+	cfg.AccountID = types.StringValue(accID)
+
+	idsValue, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	cfg.IDs = idsValue
+
+	serviceAccountsValue, diags := types.ListValueFrom(ctx, serviceAccountSummaryElementType, serviceAccounts)
+	resp.Diagnostics.Append(diags...)
+	cfg.ServiceAccounts = serviceAccountsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
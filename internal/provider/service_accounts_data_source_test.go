@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrServiceAccountsDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrServiceAccountsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_service_accounts.test", "id"),
+					resource.TestCheckResourceAttr("data.scalr_service_accounts.test", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.scalr_service_accounts.active", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_service_accounts.active", "service_accounts.0.id",
+						"scalr_service_account.active", "id",
+					),
+					resource.TestCheckResourceAttr("data.scalr_service_accounts.active", "service_accounts.0.status", "Active"),
+					resource.TestCheckResourceAttr("data.scalr_service_accounts.active", "service_accounts.0.owners.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScalrServiceAccountsDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource scalr_iam_team owner {
+  name       = "test-sa-owner-%d"
+  account_id = "%s"
+}
+
+resource scalr_service_account active {
+  name       = "test-sa-%[1]d-active"
+  account_id = "%[2]s"
+  owners     = [scalr_iam_team.owner.id]
+}
+
+resource scalr_service_account inactive {
+  name       = "test-sa-%[1]d-inactive"
+  account_id = "%[2]s"
+  status     = "Inactive"
+}
+
+data scalr_service_accounts test {
+  name       = "test-sa-%[1]d"
+  account_id = "%[2]s"
+  depends_on = [scalr_service_account.active, scalr_service_account.inactive]
+}
+
+data scalr_service_accounts active {
+  name       = "test-sa-%[1]d"
+  status     = "Active"
+  account_id = "%[2]s"
+  depends_on = [scalr_service_account.active, scalr_service_account.inactive]
+}`, rInt, defaultAccount)
+}
@@ -0,0 +1,440 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+	"github.com/scalr/go-scalr/v2/scalr/value"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/defaults"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ resource.Resource                     = &sshKeyResource{}
+	_ resource.ResourceWithConfigure        = &sshKeyResource{}
+	_ resource.ResourceWithConfigValidators = &sshKeyResource{}
+	_ resource.ResourceWithModifyPlan       = &sshKeyResource{}
+	_ resource.ResourceWithImportState      = &sshKeyResource{}
+)
+
+func newSSHKeyResource() resource.Resource {
+	return &sshKeyResource{}
+}
+
+// sshKeyResource defines the resource implementation.
+type sshKeyResource struct {
+	framework.ResourceWithScalrClient
+}
+
+// sshKeyResourceModel describes the resource data model.
+type sshKeyResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	PrivateKey          types.String `tfsdk:"private_key"`
+	PrivateKeyWO        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion types.Int64  `tfsdk:"private_key_wo_version"`
+	Fingerprint         types.String `tfsdk:"fingerprint"`
+	AccountID           types.String `tfsdk:"account_id"`
+	Environments        types.Set    `tfsdk:"environments"`
+}
+
+// sshKeyAPIErrorPaths maps the API error sources of the SSH key to its attributes.
+var sshKeyAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/name":            path.Root("name"),
+	"/data/attributes/private-key":     path.Root("private_key"),
+	"/data/relationships/environments": path.Root("environments"),
+}
+
+func (r *sshKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+func (r *sshKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource to manage Scalr SSH keys with options for sharing and environment linkage." +
+			" The private key is updated in place, so the workspaces using the key keep their access during a rotation.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the SSH key. Must be unique within an account.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "The private key for the SSH key. Stored in state; use `private_key_wo` instead to keep it out of the state.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.PreferWriteOnlyAttribute(path.MatchRoot("private_key_wo")),
+				},
+			},
+			"private_key_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only private key for the SSH key. Use instead of `private_key` when working with ephemeral values." +
+					" Not stored in state. Requires `private_key_wo_version` to trigger updates.",
+				Optional:  true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("private_key")),
+				},
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version number for `private_key_wo`. Change this number to rotate the key to the current `private_key_wo` during an update.",
+				Optional:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "The SHA256 fingerprint of the public key, in the `ssh-keygen -l` format." +
+					" It is computed from the configured private key, as the API never returns the key.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The account ID to which the SSH key belongs.",
+				Optional:            true,
+				Computed:            true,
+				Default:             defaults.AccountIDRequired(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environments": schema.SetAttribute{
+				MarkdownDescription: "The environments where the SSH key can be used. Use `[\"*\"]` to share with all environments.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidation.StringIsNotWhiteSpace()),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *sshKeyResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("private_key"),
+			path.MatchRoot("private_key_wo"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("private_key_wo"),
+			path.MatchRoot("private_key_wo_version"),
+		),
+	}
+}
+
+// ModifyPlan computes the fingerprint of the private key that is going to be applied.
+// A write-only key is applied only when its version changes, so the fingerprint is kept otherwise.
+func (r *sshKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state sshKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := config.PrivateKey
+	if key.IsNull() {
+		key = config.PrivateKeyWO
+		if !req.State.Raw.IsNull() && state.PrivateKey.IsNull() && plan.PrivateKeyWOVersion.Equal(state.PrivateKeyWOVersion) {
+			return
+		}
+	} else if !req.State.Raw.IsNull() && key.Equal(state.PrivateKey) && !state.Fingerprint.IsNull() {
+		return
+	}
+
+	fingerprint := types.StringUnknown()
+	if !key.IsUnknown() && !key.IsNull() {
+		fp, err := sshKeyFingerprint(key.ValueString())
+		if err != nil {
+			// Leave the validation of the key to the API, which may support more formats.
+			resp.Diagnostics.AddAttributeWarning(
+				sshKeyConfiguredAttribute(config),
+				"Unable to compute the SSH key fingerprint",
+				fmt.Sprintf("Could not parse the SSH private key: %s. The fingerprint will be empty.", err),
+			)
+			fingerprint = types.StringNull()
+		} else {
+			fingerprint = types.StringValue(fp)
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fingerprint"), fingerprint)...)
+}
+
+func (r *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config, plan sshKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateKey := plan.PrivateKey
+	if privateKey.IsNull() {
+		privateKey = config.PrivateKeyWO
+	}
+
+	opts := schemas.SSHKeyRequest{
+		Attributes: schemas.SSHKeyAttributesRequest{
+			Name:       value.Set(plan.Name.ValueString()),
+			PrivateKey: value.Set(privateKey.ValueString()),
+		},
+		Relationships: schemas.SSHKeyRelationshipsRequest{
+			Account: value.Set(schemas.Account{ID: plan.AccountID.ValueString()}),
+		},
+	}
+
+	if !plan.Environments.IsUnknown() && !plan.Environments.IsNull() {
+		envs, diags := framework.ExpandRelationshipIDsSet(
+			ctx, plan.Environments, func(id string) schemas.Environment {
+				return schemas.Environment{ID: id}
+			},
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if len(envs) == 1 && envs[0].ID == "*" {
+			opts.Attributes.IsShared = value.Set(true)
+		} else if len(envs) > 0 {
+			opts.Relationships.Environments = value.Set(envs)
+		}
+	}
+
+	sshKey, err := r.ClientV2.SSHKey.CreateSshKey(ctx, &opts)
+	if err != nil {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error creating SSH key", err,
+			framework.WithAttributePaths(sshKeyAPIErrorPaths),
+		)...)
+		return
+	}
+	plan.Fingerprint = sshKeyFingerprintValue(privateKey.ValueString())
+
+	resp.Diagnostics.Append(sshKeyResourceModelFromAPI(ctx, sshKey, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state sshKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed resource state from API
+	sshKey, err := r.ClientV2.SSHKey.GetSshKey(ctx, state.Id.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving SSH key", err.Error())
+		return
+	}
+
+	// Overwrite attributes with refreshed values
+	resp.Diagnostics.Append(sshKeyResourceModelFromAPI(ctx, sshKey, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The state written by earlier provider versions has no fingerprint yet.
+	if state.Fingerprint.IsNull() && !state.PrivateKey.IsNull() {
+		state.Fingerprint = sshKeyFingerprintValue(state.PrivateKey.ValueString())
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *sshKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config, plan, state sshKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := schemas.SSHKeyRequest{}
+
+	if !plan.Name.Equal(state.Name) {
+		opts.Attributes.Name = value.Set(plan.Name.ValueString())
+	}
+
+	var privateKey *string
+	if plan.PrivateKey.IsNull() {
+		// Switching from `private_key` to `private_key_wo` applies the write-only key as well.
+		if !plan.PrivateKeyWOVersion.Equal(state.PrivateKeyWOVersion) || !state.PrivateKey.IsNull() {
+			privateKey = config.PrivateKeyWO.ValueStringPointer()
+		}
+	} else if !plan.PrivateKey.Equal(state.PrivateKey) {
+		privateKey = plan.PrivateKey.ValueStringPointer()
+	}
+	if privateKey != nil {
+		opts.Attributes.PrivateKey = value.Set(*privateKey)
+	}
+
+	if !plan.Environments.Equal(state.Environments) && !plan.Environments.IsUnknown() && !plan.Environments.IsNull() {
+		envs, diags := framework.ExpandRelationshipIDsSet(
+			ctx, plan.Environments, func(id string) schemas.Environment {
+				return schemas.Environment{ID: id}
+			},
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if len(envs) == 1 && envs[0].ID == "*" {
+			opts.Attributes.IsShared = value.Set(true)
+			opts.Relationships.Environments = value.Set([]schemas.Environment{})
+		} else {
+			opts.Attributes.IsShared = value.Set(false)
+			opts.Relationships.Environments = value.Set(envs)
+		}
+	}
+
+	// Update existing resource
+	sshKey, err := r.ClientV2.SSHKey.UpdateSshKey(ctx, plan.Id.ValueString(), &opts)
+	if err != nil {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error updating SSH key", err,
+			framework.WithAttributePaths(sshKeyAPIErrorPaths),
+		)...)
+		return
+	}
+	if privateKey != nil {
+		plan.Fingerprint = sshKeyFingerprintValue(*privateKey)
+	}
+
+	resp.Diagnostics.Append(sshKeyResourceModelFromAPI(ctx, sshKey, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *sshKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state sshKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.ClientV2.SSHKey.DeleteSshKey(ctx, state.Id.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting SSH key", err)...)
+		return
+	}
+}
+
+func (r *sshKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// sshKeyResourceModelFromAPI overwrites the attributes returned by the API.
+// The private key is never returned, so the key and its fingerprint are kept as is.
+func sshKeyResourceModelFromAPI(ctx context.Context, sshKey *schemas.SSHKey, m *sshKeyResourceModel) (diags diag.Diagnostics) {
+	m.Id = types.StringValue(sshKey.ID)
+	m.Name = types.StringValue(sshKey.Attributes.Name)
+	if sshKey.Relationships.Account != nil {
+		m.AccountID = types.StringValue(sshKey.Relationships.Account.ID)
+	}
+	if m.Fingerprint.IsUnknown() {
+		m.Fingerprint = types.StringNull()
+	}
+
+	envs := []string{"*"}
+	if !sshKey.Attributes.IsShared {
+		envs = make([]string, len(sshKey.Relationships.Environments))
+		for i, env := range sshKey.Relationships.Environments {
+			envs[i] = env.ID
+		}
+	}
+	envsValue, d := types.SetValueFrom(ctx, types.StringType, envs)
+	diags.Append(d...)
+	m.Environments = envsValue
+
+	return diags
+}
+
+// sshKeyFingerprint returns the SHA256 fingerprint of the public key of a private key.
+// The public key of an encrypted key in the OpenSSH format is readable without the passphrase.
+func sshKeyFingerprint(privateKey string) (string, error) {
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	if err != nil {
+		var missing *ssh.PassphraseMissingError
+		if errors.As(err, &missing) && missing.PublicKey != nil {
+			return ssh.FingerprintSHA256(missing.PublicKey), nil
+		}
+		return "", err
+	}
+	return ssh.FingerprintSHA256(signer.PublicKey()), nil
+}
+
+// sshKeyFingerprintValue returns the fingerprint of a private key, or null if the key can't be parsed.
+func sshKeyFingerprintValue(privateKey string) types.String {
+	fp, err := sshKeyFingerprint(privateKey)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(fp)
+}
+
+func sshKeyConfiguredAttribute(config sshKeyResourceModel) path.Path {
+	if config.PrivateKey.IsNull() {
+		return path.Root("private_key_wo")
+	}
+	return path.Root("private_key")
+}
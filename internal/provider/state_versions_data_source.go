@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/ops/state_version"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource              = &stateVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &stateVersionsDataSource{}
+)
+
+func newStateVersionsDataSource() datasource.DataSource {
+	return &stateVersionsDataSource{}
+}
+
+// stateVersionsDataSource defines the data source implementation.
+type stateVersionsDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// stateVersionsDataSourceModel describes the data source data model.
+type stateVersionsDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	WorkspaceID   types.String `tfsdk:"workspace_id"`
+	MaxResults    types.Int64  `tfsdk:"max_results"`
+	IDs           types.Set    `tfsdk:"ids"`
+	StateVersions types.List   `tfsdk:"state_versions"`
+}
+
+// stateVersionModel describes a single state version in the list.
+type stateVersionModel struct {
+	Id            types.String `tfsdk:"id"`
+	Serial        types.Int64  `tfsdk:"serial"`
+	Lineage       types.String `tfsdk:"lineage"`
+	Md5           types.String `tfsdk:"md5"`
+	Size          types.Int64  `tfsdk:"size"`
+	ResourceCount types.Int64  `tfsdk:"resource_count"`
+	RunID         types.String `tfsdk:"run_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	Outputs       types.List   `tfsdk:"outputs"`
+}
+
+// stateVersionOutputModel describes an output value of a state version.
+type stateVersionOutputModel struct {
+	Name      types.String `tfsdk:"name"`
+	Sensitive types.Bool   `tfsdk:"sensitive"`
+	Value     types.String `tfsdk:"value"`
+}
+
+var stateVersionOutputElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":      types.StringType,
+		"sensitive": types.BoolType,
+		"value":     types.StringType,
+	},
+}
+
+var stateVersionElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":             types.StringType,
+		"serial":         types.Int64Type,
+		"lineage":        types.StringType,
+		"md5":            types.StringType,
+		"size":           types.Int64Type,
+		"resource_count": types.Int64Type,
+		"run_id":         types.StringType,
+		"created_at":     types.StringType,
+		"outputs":        types.ListType{ElemType: stateVersionOutputElementType},
+	},
+}
+
+func (d *stateVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_state_versions"
+}
+
+func (d *stateVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the history of state versions of a workspace.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of this data source.",
+				Computed:            true,
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace, in the format `ws-<RANDOM STRING>`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of the most recent state versions to return. By default, all state versions are returned.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "The list of state version IDs, in the format [`sv-xxxxxxxxxxx`, `sv-yyyyyyyyy`].",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"state_versions": schema.ListAttribute{
+				MarkdownDescription: "The list of state versions, newest first. Each state version has the `id`," +
+					" the `serial` and `lineage` of the state file, its `md5` hash and `size` in bytes," +
+					" the `resource_count` of managed resources, the `run_id` of the run that created it, if any," +
+					" and the `created_at` time in RFC3339 format. The `outputs` hold the `name` of each output," +
+					" whether it is `sensitive` and its JSON-encoded `value`. The value of a sensitive output is always null.",
+				ElementType: stateVersionElementType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *stateVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg stateVersionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := cfg.WorkspaceID.ValueString()
+	opts := &state_version.ListStateVersionsOptions{
+		Filter:   map[string]string{"workspace": workspaceID},
+		Sort:     []string{"-serial"},
+		PageSize: 100,
+	}
+
+	id := "state-versions" + workspaceID
+	if !cfg.MaxResults.IsNull() {
+		id += cfg.MaxResults.String()
+		opts.PageSize = int(min(cfg.MaxResults.ValueInt64(), 100))
+	}
+
+	// The state versions are requested newest first, so the paging stops as soon as enough versions are collected.
+	versions := make([]stateVersionModel, 0)
+	ids := make([]string, 0)
+	for sv, err := range d.ClientV2.StateVersion.ListStateVersionsIter(ctx, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving state versions", err.Error())
+			return
+		}
+
+		if sv.Relationships.Workspace != nil && sv.Relationships.Workspace.ID != workspaceID {
+			continue
+		}
+
+		outputs, diags := types.ListValueFrom(ctx, stateVersionOutputElementType, stateVersionOutputs(sv))
+		resp.Diagnostics.Append(diags...)
+
+		version := stateVersionModel{
+			Id:            types.StringValue(sv.ID),
+			Serial:        types.Int64Value(int64(sv.Attributes.Serial)),
+			Lineage:       types.StringPointerValue(sv.Attributes.Lineage),
+			Md5:           types.StringValue(sv.Attributes.Md5),
+			Size:          types.Int64Value(int64(sv.Attributes.Size)),
+			ResourceCount: types.Int64Value(int64(len(sv.Attributes.Resources))),
+			RunID:         types.StringNull(),
+			CreatedAt:     types.StringValue(sv.Attributes.CreatedAt.Format(time.RFC3339)),
+			Outputs:       outputs,
+		}
+		if sv.Relationships.Run != nil {
+			version.RunID = types.StringValue(sv.Relationships.Run.ID)
+		}
+
+		versions = append(versions, version)
+		ids = append(ids, sv.ID)
+		if !cfg.MaxResults.IsNull() && int64(len(versions)) >= cfg.MaxResults.ValueInt64() {
+			break
+		}
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		if versions[i].Serial.ValueInt64() != versions[j].Serial.ValueInt64() {
+			return versions[i].Serial.ValueInt64() > versions[j].Serial.ValueInt64()
+		}
+		return versions[i].CreatedAt.ValueString() > versions[j].CreatedAt.ValueString()
+	})
+
+	cfg.Id = types.StringValue(fmt.Sprintf("%d", framework.HashString(id)))
+
+	idsValue, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	cfg.IDs = idsValue
+
+	versionsValue, diags := types.ListValueFrom(ctx, stateVersionElementType, versions)
+	resp.Diagnostics.Append(diags...)
+	cfg.StateVersions = versionsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// stateVersionOutputs converts the outputs of a state version, sorted by name.
+// Values are JSON-encoded as outputs can be of any type; sensitive values are never exposed.
+func stateVersionOutputs(sv schemas.StateVersion) []stateVersionOutputModel {
+	outputs := make([]stateVersionOutputModel, 0)
+	if sv.Attributes.Outputs == nil {
+		return outputs
+	}
+
+	for _, o := range *sv.Attributes.Outputs {
+		name, _ := o["name"].(string)
+		sensitive, _ := o["sensitive"].(bool)
+
+		output := stateVersionOutputModel{
+			Name:      types.StringValue(name),
+			Sensitive: types.BoolValue(sensitive),
+			Value:     types.StringNull(),
+		}
+		if v, ok := o["value"]; ok && !sensitive {
+			if raw, err := json.Marshal(v); err == nil {
+				output.Value = types.StringValue(string(raw))
+			}
+		}
+		outputs = append(outputs, output)
+	}
+
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].Name.ValueString() < outputs[j].Name.ValueString()
+	})
+	return outputs
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrStateVersionsDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      `data scalr_state_versions test {}`,
+				ExpectError: regexp.MustCompile(`The argument "workspace_id" is required`),
+				PlanOnly:    true,
+			},
+			{
+				Config: testAccScalrWorkspaceStateConfig(rInt, testAccScalrWorkspaceStateContent(1, "hello"), false) + `
+
+data scalr_state_versions test {
+  workspace_id = scalr_workspace_state.test.workspace_id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_state_versions.test", "id"),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.test", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.test", "state_versions.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_state_versions.test", "state_versions.0.id",
+						"scalr_workspace_state.test", "state_version_id",
+					),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.test", "state_versions.0.serial", "1"),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.test", "state_versions.0.lineage", "test-lineage"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_state_versions.test", "state_versions.0.md5",
+						"scalr_workspace_state.test", "md5",
+					),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.test", "state_versions.0.resource_count", "1"),
+					resource.TestCheckNoResourceAttr("data.scalr_state_versions.test", "state_versions.0.run_id"),
+					resource.TestCheckResourceAttrSet("data.scalr_state_versions.test", "state_versions.0.created_at"),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.test", "state_versions.0.outputs.#", "1"),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.test", "state_versions.0.outputs.0.name", "greeting"),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.test", "state_versions.0.outputs.0.sensitive", "false"),
+					resource.TestCheckResourceAttr(
+						"data.scalr_state_versions.test", "state_versions.0.outputs.0.value", fmt.Sprintf("%q", "hello"),
+					),
+				),
+			},
+			{
+				Config: testAccScalrWorkspaceStateConfig(rInt, testAccScalrWorkspaceStateContent(1, "hello"), false) + `
+
+data scalr_state_versions test {
+  workspace_id = scalr_workspace_state.test.workspace_id
+  max_results  = 0
+}`,
+				ExpectError: regexp.MustCompile(`Attribute max_results value must be at least 1`),
+				PlanOnly:    true,
+			},
+			{
+				Config: testAccScalrWorkspaceStateConfig(rInt, testAccScalrWorkspaceStateContent(2, "world"), false) + `
+
+data scalr_state_versions test {
+  workspace_id = scalr_workspace_state.test.workspace_id
+  depends_on   = [scalr_workspace_state.test]
+}
+
+data scalr_state_versions latest {
+  workspace_id = scalr_workspace_state.test.workspace_id
+  max_results  = 1
+  depends_on   = [scalr_workspace_state.test]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scalr_state_versions.test", "state_versions.#", "2"),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.test", "state_versions.0.serial", "2"),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.test", "state_versions.1.serial", "1"),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.latest", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.latest", "state_versions.#", "1"),
+					resource.TestCheckResourceAttr("data.scalr_state_versions.latest", "state_versions.0.serial", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_state_versions.latest", "state_versions.0.id",
+						"scalr_workspace_state.test", "state_version_id",
+					),
+				),
+			},
+		},
+	})
+}
@@ -113,7 +113,7 @@ func (r *storageProfileResource) Create(ctx context.Context, req resource.Create
 
 	storageProfile, err := r.Client.StorageProfiles.Create(ctx, opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating storage_profile", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error creating storage_profile", err)...)
 		return
 	}
 
@@ -230,7 +230,7 @@ func (r *storageProfileResource) Update(ctx context.Context, req resource.Update
 	// Update existing resource
 	storageProfile, err := r.Client.StorageProfiles.Update(ctx, plan.Id.ValueString(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating storage_profile", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error updating storage_profile", err)...)
 		return
 	}
 
@@ -256,7 +256,7 @@ func (r *storageProfileResource) Delete(ctx context.Context, req resource.Delete
 
 	err := r.Client.StorageProfiles.Delete(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, scalr.ErrResourceNotFound) {
-		resp.Diagnostics.AddError("Error deleting storage_profile", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting storage_profile", err)...)
 		return
 	}
 }
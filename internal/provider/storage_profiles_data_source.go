@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/ops/environment"
+	"github.com/scalr/go-scalr/v2/scalr/ops/storage_profile"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/defaults"
+)
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource              = &storageProfilesDataSource{}
+	_ datasource.DataSourceWithConfigure = &storageProfilesDataSource{}
+)
+
+func newStorageProfilesDataSource() datasource.DataSource {
+	return &storageProfilesDataSource{}
+}
+
+// storageProfilesDataSource defines the data source implementation.
+type storageProfilesDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// storageProfilesDataSourceModel describes the data source data model.
+type storageProfilesDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	BackendType     types.String `tfsdk:"backend_type"`
+	AccountID       types.String `tfsdk:"account_id"`
+	IDs             types.Set    `tfsdk:"ids"`
+	StorageProfiles types.List   `tfsdk:"storage_profiles"`
+}
+
+// storageProfilesItemModel describes a single storage profile in the list.
+type storageProfilesItemModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	BackendType    types.String `tfsdk:"backend_type"`
+	Default        types.Bool   `tfsdk:"default"`
+	ErrorMessage   types.String `tfsdk:"error_message"`
+	EnvironmentIDs types.Set    `tfsdk:"environment_ids"`
+}
+
+var storageProfilesItemElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":              types.StringType,
+		"name":            types.StringType,
+		"backend_type":    types.StringType,
+		"default":         types.BoolType,
+		"error_message":   types.StringType,
+		"environment_ids": types.SetType{ElemType: types.StringType},
+	},
+}
+
+func (d *storageProfilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_profiles"
+}
+
+func (d *storageProfilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a list of storage profiles along with the environments that use them.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of this data source.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of a storage profile to filter by.",
+				Optional:            true,
+			},
+			"backend_type": schema.StringAttribute{
+				MarkdownDescription: "Only return storage profiles with this backend type. Can be `aws-s3`, `azurerm` or `google`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(schemas.StorageProfileBackendTypeAwsS3),
+						string(schemas.StorageProfileBackendTypeAzurerm),
+						string(schemas.StorageProfileBackendTypeGoogle),
+					),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Scalr account, in the format `acc-<RANDOM STRING>`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "The list of storage profile IDs.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"storage_profiles": schema.ListAttribute{
+				MarkdownDescription: "The list of storage profiles, sorted by name. Each storage profile has the `id`," +
+					" the `name`, the `backend_type`, whether it is the `default` one, the `error_message` if Scalr" +
+					" failed to use the storage, and the `environment_ids` of the environments that store their data in it." +
+					" Environments without an explicitly linked storage profile are counted towards the default one.",
+				ElementType: storageProfilesItemElementType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *storageProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg storageProfilesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var accID string
+	if !cfg.AccountID.IsNull() {
+		accID = cfg.AccountID.ValueString()
+	} else {
+		var diags diag.Diagnostics
+		accID, diags = defaults.GetDefaultScalrAccountID()
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := strings.Builder{} // holds the string to build a unique resource id hash
+	id.WriteString(accID)
+
+	opts := &storage_profile.ListStorageProfilesOptions{
+		Filter: map[string]string{},
+	}
+	if !cfg.Name.IsNull() {
+		id.WriteString(cfg.Name.ValueString())
+		opts.Filter["name"] = cfg.Name.ValueString()
+	}
+	if !cfg.BackendType.IsNull() {
+		id.WriteString(cfg.BackendType.ValueString())
+	}
+
+	var profiles []schemas.StorageProfile
+	for sp, err := range d.ClientV2.StorageProfile.ListStorageProfilesIter(ctx, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving storage profiles", err.Error())
+			return
+		}
+		if !cfg.BackendType.IsNull() && string(sp.Attributes.BackendType) != cfg.BackendType.ValueString() {
+			continue
+		}
+		profiles = append(profiles, sp)
+	}
+
+	envIDs, diags := d.environmentsByStorageProfile(ctx, accID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sort.SliceStable(profiles, func(i, j int) bool {
+		return profiles[i].Attributes.Name < profiles[j].Attributes.Name
+	})
+
+	ids := make([]string, len(profiles))
+	items := make([]storageProfilesItemModel, len(profiles))
+	for i, sp := range profiles {
+		ids[i] = sp.ID
+
+		key := sp.ID
+		if sp.Attributes.Default {
+			key = ""
+		}
+		environmentIDs, setDiags := types.SetValueFrom(ctx, types.StringType, append([]string{}, envIDs[key]...))
+		resp.Diagnostics.Append(setDiags...)
+
+		items[i] = storageProfilesItemModel{
+			Id:             types.StringValue(sp.ID),
+			Name:           types.StringValue(sp.Attributes.Name),
+			BackendType:    types.StringValue(string(sp.Attributes.BackendType)),
+			Default:        types.BoolValue(sp.Attributes.Default),
+			ErrorMessage:   types.StringPointerValue(sp.Attributes.ErrorMessage),
+			EnvironmentIDs: environmentIDs,
+		}
+	}
+
+	cfg.Id = types.StringValue(fmt.Sprintf("%d", framework.HashString(id.String())))
+	cfg.AccountID = types.StringValue(accID)
+
+	idsValue, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	cfg.IDs = idsValue
+
+	itemsValue, diags := types.ListValueFrom(ctx, storageProfilesItemElementType, items)
+	resp.Diagnostics.Append(diags...)
+	cfg.StorageProfiles = itemsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+// environmentsByStorageProfile maps storage profile IDs to the IDs of the environments using them.
+// The environments relying on the default storage profile are listed under the empty key.
+// Explicitly linking the default profile counts as using it as well.
+func (d *storageProfilesDataSource) environmentsByStorageProfile(
+	ctx context.Context,
+	accountID string,
+) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	defaultID := ""
+	opts := &storage_profile.ListStorageProfilesOptions{
+		Filter: map[string]string{"default": "true"},
+	}
+	for sp, err := range d.ClientV2.StorageProfile.ListStorageProfilesIter(ctx, opts) {
+		if err != nil {
+			diags.AddError("Error retrieving default storage profile", err.Error())
+			return nil, diags
+		}
+		if sp.Attributes.Default {
+			defaultID = sp.ID
+		}
+	}
+
+	result := make(map[string][]string)
+	envOpts := &environment.ListEnvironmentsOptions{
+		Filter: map[string]string{"account": accountID},
+	}
+	for env, err := range d.ClientV2.Environment.ListEnvironmentsIter(ctx, envOpts) {
+		if err != nil {
+			diags.AddError("Error retrieving environments", err.Error())
+			return nil, diags
+		}
+
+		key := ""
+		if env.Relationships.StorageProfile != nil && env.Relationships.StorageProfile.ID != defaultID {
+			key = env.Relationships.StorageProfile.ID
+		}
+		result[key] = append(result[key], env.ID)
+	}
+
+	return result, diags
+}
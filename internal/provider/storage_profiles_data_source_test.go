@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrStorageProfilesDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data scalr_storage_profiles test {
+  backend_type = "s3"
+}`,
+				ExpectError: regexp.MustCompile(`Attribute backend_type value must be one of`),
+				PlanOnly:    true,
+			},
+			{
+				Config: testAccScalrStorageProfilesDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_storage_profiles.all", "id"),
+					resource.TestCheckResourceAttr("data.scalr_storage_profiles.all", "account_id", defaultAccount),
+					resource.TestCheckResourceAttr("data.scalr_storage_profiles.missing", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.scalr_storage_profiles.missing", "storage_profiles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScalrStorageProfilesDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource scalr_environment test {
+  name       = "storage-profiles-%d"
+  account_id = "%s"
+}
+
+data scalr_storage_profiles all {
+  depends_on = [scalr_environment.test]
+}
+
+data scalr_storage_profiles missing {
+  name = "storage-profile-missing-%[1]d"
+}`, rInt, defaultAccount)
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	scalrV2 "github.com/scalr/go-scalr/v2/scalr"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ resource.Resource                = &tagLinkResource{}
+	_ resource.ResourceWithConfigure   = &tagLinkResource{}
+	_ resource.ResourceWithImportState = &tagLinkResource{}
+)
+
+// tagLinkConfig describes a taggable object and the API calls that manage its tags.
+type tagLinkConfig struct {
+	// typeName is the suffix of the resource type name, as in `workspace_tag`.
+	typeName string
+	// object is the name of the tagged object in messages, as in `workspace`.
+	object string
+	// objectAttribute is the name of the attribute holding the ID of the tagged object.
+	objectAttribute string
+	// objectDescription describes the objectAttribute.
+	objectDescription string
+	// description is the description of the resource.
+	description string
+	// lookupCache is the lookup cache category to invalidate when the tags are changed.
+	lookupCache string
+	// permission is the permission required to change the tags.
+	permission string
+
+	addTags    func(ctx context.Context, c *scalrV2.Client, objectID string, tags []schemas.Tag) error
+	listTags   func(ctx context.Context, c *scalrV2.Client, objectID string) iter.Seq2[schemas.Tag, error]
+	deleteTags func(ctx context.Context, c *scalrV2.Client, objectID string, tags []schemas.Tag) error
+}
+
+// tagLinkResource attaches a single tag to an object, leaving its other tags untouched.
+type tagLinkResource struct {
+	framework.ResourceWithScalrClient
+	cfg tagLinkConfig
+}
+
+func (r *tagLinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.cfg.typeName
+}
+
+func (r *tagLinkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: r.cfg.description,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The ID of this resource, in the format `<%s>/<tag_id>`.", r.cfg.objectAttribute),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			r.cfg.objectAttribute: schema.StringAttribute{
+				MarkdownDescription: r.cfg.objectDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"tag_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the tag, in the format `tag-<RANDOM STRING>`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *tagLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer r.LookupCache.Invalidate(r.cfg.lookupCache)
+
+	var objectID, tagID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(r.cfg.objectAttribute), &objectID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tag_id"), &tagID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.cfg.addTags(ctx, r.ClientV2, objectID.ValueString(), []schemas.Tag{{ID: tagID.ValueString()}})
+	if err != nil {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error adding tag to "+r.cfg.object, err,
+			framework.WithRequiredPermission(r.cfg.permission),
+			framework.WithAttributePaths(tagLinkAPIErrorPaths),
+		)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), objectID.ValueString()+"/"+tagID.ValueString())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.cfg.objectAttribute), objectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag_id"), tagID)...)
+}
+
+func (r *tagLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var objectID, tagID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(r.cfg.objectAttribute), &objectID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tag_id"), &tagID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for tag, err := range r.cfg.listTags(ctx, r.ClientV2, objectID.ValueString()) {
+		if err != nil {
+			if errors.Is(err, client.ErrNotFound) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error reading tags for %s %s", r.cfg.object, objectID.ValueString()),
+				err.Error(),
+			)
+			return
+		}
+		if tag.ID == tagID.ValueString() {
+			return
+		}
+	}
+
+	// The tag was detached outside of Terraform.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *tagLinkResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// Not updatable - any attribute change forces recreate.
+}
+
+func (r *tagLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer r.LookupCache.Invalidate(r.cfg.lookupCache)
+
+	var objectID, tagID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(r.cfg.objectAttribute), &objectID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tag_id"), &tagID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.cfg.deleteTags(ctx, r.ClientV2, objectID.ValueString(), []schemas.Tag{{ID: tagID.ValueString()}})
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error removing tag from "+r.cfg.object, err,
+			framework.WithRequiredPermission(r.cfg.permission),
+		)...)
+		return
+	}
+}
+
+func (r *tagLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected import ID in the format <%s>/<tag_id>, got: %q", r.cfg.objectAttribute, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.cfg.objectAttribute), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag_id"), parts[1])...)
+}
+
+// tagLinkAPIErrorPaths maps the API error sources of the tag link to its attributes.
+var tagLinkAPIErrorPaths = framework.APIErrorPaths{
+	"/data/relationships/tags": path.Root("tag_id"),
+}
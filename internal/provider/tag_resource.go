@@ -45,6 +45,11 @@ type tagResourceModel struct {
 	AccountID types.String `tfsdk:"account_id"`
 }
 
+// tagAPIErrorPaths maps the API error sources of the tag to its attributes.
+var tagAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/name": path.Root("name"),
+}
+
 func (r *tagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_tag"
 }
@@ -99,7 +104,10 @@ func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 	tag, err := r.ClientV2.Tag.CreateTag(ctx, &opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating tag", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error creating tag", err,
+			framework.WithAttributePaths(tagAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -159,7 +167,10 @@ func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	// Update existing resource
 	tag, err := r.ClientV2.Tag.UpdateTag(ctx, plan.Id.ValueString(), &opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating tag", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error updating tag", err,
+			framework.WithAttributePaths(tagAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -184,7 +195,7 @@ func (r *tagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 	err := r.ClientV2.Tag.DeleteTag(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, client.ErrNotFound) {
-		resp.Diagnostics.AddError("Error deleting tag", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting tag", err)...)
 		return
 	}
 }
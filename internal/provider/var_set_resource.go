@@ -99,6 +99,14 @@ func varSetResourceModelFromAPI(
 	return model, diags
 }
 
+// varSetAPIErrorPaths maps the API error sources of the var set to its attributes.
+var varSetAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/name":            path.Root("name"),
+	"/data/attributes/description":     path.Root("description"),
+	"/data/relationships/environments": path.Root("environments"),
+	"/data/relationships/owners":       path.Root("owners"),
+}
+
 func (r *varSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_var_set"
 }
@@ -218,7 +226,10 @@ func (r *varSetResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	vs, err := r.ClientV2.VariableSet.CreateVarSet(ctx, &opts, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating var_set", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error creating var_set", err,
+			framework.WithAttributePaths(varSetAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -311,7 +322,10 @@ func (r *varSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	vs, err := r.ClientV2.VariableSet.UpdateVarSet(ctx, plan.Id.ValueString(), &opts, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating var_set", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error updating var_set", err,
+			framework.WithAttributePaths(varSetAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -333,7 +347,7 @@ func (r *varSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	err := r.ClientV2.VariableSet.DeleteVarSet(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, client.ErrNotFound) {
-		resp.Diagnostics.AddError("Error deleting var_set", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting var_set", err)...)
 		return
 	}
 }
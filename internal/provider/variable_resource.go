@@ -51,6 +51,20 @@ type privateMeta struct {
 	IsWriteOnly bool `json:"is_write_only"`
 }
 
+// variableAPIErrorPaths maps the API error sources of the variable to its attributes.
+var variableAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/key":            path.Root("key"),
+	"/data/attributes/value":          path.Root("value"),
+	"/data/attributes/category":       path.Root("category"),
+	"/data/attributes/description":    path.Root("description"),
+	"/data/attributes/final":          path.Root("final"),
+	"/data/attributes/hcl":            path.Root("hcl"),
+	"/data/attributes/sensitive":      path.Root("sensitive"),
+	"/data/relationships/environment": path.Root("environment_id"),
+	"/data/relationships/var-set":     path.Root("var_set_id"),
+	"/data/relationships/workspace":   path.Root("workspace_id"),
+}
+
 func (r *variableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_variable"
 }
@@ -132,7 +146,10 @@ func (r *variableResource) createClassicVariable(
 
 	variable, err := r.ClientV2.Variable.CreateVariable(ctx, &createReq, &createOpts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating variable", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error creating variable", err,
+			framework.WithAttributePaths(variableAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -197,7 +214,10 @@ func (r *variableResource) createVarSetVariable(
 
 	variable, err := r.ClientV2.VariableSetVariable.CreateVarSetVariable(ctx, &createReq, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating variable", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error creating variable", err,
+			framework.WithAttributePaths(variableAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -394,7 +414,10 @@ func (r *variableResource) updateClassicVariable(
 	// Update existing resource
 	variable, err := r.ClientV2.Variable.UpdateVariable(ctx, plan.Id.ValueString(), &updateReq, &updateOpts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating variable", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error updating variable", err,
+			framework.WithAttributePaths(variableAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -478,7 +501,10 @@ func (r *variableResource) updateVarSetVariable(
 	// Update existing resource
 	variable, err := r.ClientV2.VariableSetVariable.UpdateVarSetVariable(ctx, plan.Id.ValueString(), &updateReq, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating variable", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error updating variable", err,
+			framework.WithAttributePaths(variableAPIErrorPaths),
+		)...)
 		return
 	}
 
@@ -526,7 +552,7 @@ func (r *variableResource) deleteClassicVariable(
 
 	err := r.ClientV2.Variable.DeleteVariable(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, client.ErrNotFound) {
-		resp.Diagnostics.AddError("Error deleting variable", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting variable", err)...)
 		return
 	}
 }
@@ -545,7 +571,7 @@ func (r *variableResource) deleteVarSetVariable(
 
 	err := r.ClientV2.VariableSetVariable.DeleteVarSetVariable(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, client.ErrNotFound) {
-		resp.Diagnostics.AddError("Error deleting variable", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting variable", err)...)
 		return
 	}
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 const baseForUpdate = `
@@ -287,7 +289,7 @@ func TestAccScalrVariable_UpgradeFromSDK(t *testing.T) {
 }
 
 func variableFromState(s *terraform.State, n string, v *scalr.Variable) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	rs, ok := s.RootModule().Resources[n]
 	if !ok {
@@ -418,7 +420,7 @@ func testAccCheckScalrVariableAttributesUpdate(
 }
 
 func testAccCheckScalrVariableDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_variable" {
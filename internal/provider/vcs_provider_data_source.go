@@ -157,7 +157,12 @@ func (d *vcsProviderDataSource) Read(ctx context.Context, req datasource.ReadReq
 		opts.Filter["agent-pool"] = cfg.AgentPoolID.ValueString()
 	}
 
-	vcsProvider, err := getVcsProvider(ctx, d.ClientV2, &opts)
+	key := fmt.Sprintf("%v", opts.Filter)
+	vcsProvider, err := framework.CachedLookup(ctx, d.LookupCache, framework.LookupVcsProviders, key,
+		func() (*schemas.VcsProvider, error) {
+			return getVcsProvider(ctx, d.ClientV2, &opts)
+		},
+	)
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving VCS provider", err.Error())
 		return
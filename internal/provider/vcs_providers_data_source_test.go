@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrVcsProvidersDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testVcsAccGithubTokenPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrVcsProvidersDataSourceConfig(rInt, githubToken),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_vcs_providers.test", "id"),
+					resource.TestCheckResourceAttr("data.scalr_vcs_providers.test", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.scalr_vcs_providers.env", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_vcs_providers.env", "ids.0",
+						"scalr_vcs_provider.shared", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccScalrVcsProvidersDataSourceConfig(rInt int, token string) string {
+	return fmt.Sprintf(`
+resource scalr_environment test {
+  name       = "test-env-vcs-providers-%d"
+  account_id = "%s"
+}
+
+resource scalr_vcs_provider shared {
+  name         = "vcs-providers-%[1]d-shared"
+  vcs_type     = "github"
+  token        = "%s"
+  account_id   = "%[2]s"
+  environments = [scalr_environment.test.id]
+}
+
+resource scalr_vcs_provider private {
+  name       = "vcs-providers-%[1]d-private"
+  vcs_type   = "github"
+  token      = "%[3]s"
+  account_id = "%[2]s"
+}
+
+data scalr_vcs_providers test {
+  name       = "like:vcs-providers-%[1]d-"
+  account_id = "%[2]s"
+  depends_on = [scalr_vcs_provider.shared, scalr_vcs_provider.private]
+}
+
+data scalr_vcs_providers env {
+  name           = "like:vcs-providers-%[1]d-"
+  environment_id = scalr_environment.test.id
+  account_id     = "%[2]s"
+  depends_on     = [scalr_vcs_provider.shared, scalr_vcs_provider.private]
+}`, rInt, defaultAccount, token)
+}
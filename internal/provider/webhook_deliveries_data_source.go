@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/ops/webhook_integration_delivery"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// webhookResponseExcerptLength is the maximum number of characters of a response body
+// exposed by the scalr_webhook_deliveries data source.
+const webhookResponseExcerptLength = 1024
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource              = &webhookDeliveriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookDeliveriesDataSource{}
+)
+
+func newWebhookDeliveriesDataSource() datasource.DataSource {
+	return &webhookDeliveriesDataSource{}
+}
+
+// webhookDeliveriesDataSource defines the data source implementation.
+type webhookDeliveriesDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// webhookDeliveriesDataSourceModel describes the data source data model.
+type webhookDeliveriesDataSourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	WebhookID  types.String `tfsdk:"webhook_id"`
+	Status     types.String `tfsdk:"status"`
+	MaxResults types.Int64  `tfsdk:"max_results"`
+	IDs        types.Set    `tfsdk:"ids"`
+	Deliveries types.List   `tfsdk:"deliveries"`
+}
+
+// webhookDeliveryModel describes a single delivery in the list.
+type webhookDeliveryModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Event               types.String `tfsdk:"event"`
+	Status              types.String `tfsdk:"status"`
+	ResponseCode        types.Int64  `tfsdk:"response_code"`
+	Attempts            types.Int64  `tfsdk:"attempts"`
+	ErrorMessage        types.String `tfsdk:"error_message"`
+	ResponseBodyExcerpt types.String `tfsdk:"response_body_excerpt"`
+	TriggeredAt         types.String `tfsdk:"triggered_at"`
+	LastAttemptAt       types.String `tfsdk:"last_attempt_at"`
+	EnvironmentID       types.String `tfsdk:"environment_id"`
+	WorkspaceID         types.String `tfsdk:"workspace_id"`
+	RunID               types.String `tfsdk:"run_id"`
+}
+
+var webhookDeliveryElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                    types.StringType,
+		"event":                 types.StringType,
+		"status":                types.StringType,
+		"response_code":         types.Int64Type,
+		"attempts":              types.Int64Type,
+		"error_message":         types.StringType,
+		"response_body_excerpt": types.StringType,
+		"triggered_at":          types.StringType,
+		"last_attempt_at":       types.StringType,
+		"environment_id":        types.StringType,
+		"workspace_id":          types.StringType,
+		"run_id":                types.StringType,
+	},
+}
+
+func (d *webhookDeliveriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_deliveries"
+}
+
+func (d *webhookDeliveriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the delivery history of a webhook.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of this data source.",
+				Computed:            true,
+			},
+			"webhook_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the webhook, in the format `wh-<RANDOM STRING>`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return deliveries with this status. Can be `pending`, `completed` or `failed`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("pending", "completed", "failed"),
+				},
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of the most recent deliveries to return. By default, all deliveries are returned.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "The list of delivery IDs.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"deliveries": schema.ListAttribute{
+				MarkdownDescription: "The list of deliveries, newest first. Each delivery has the `id`," +
+					" the `event` that triggered it, e.g. `run:completed`, its `status`," +
+					" the HTTP `response_code` of the last attempt and the number of delivery `attempts`," +
+					" the `error_message`, if any, and the first 1024 characters of the response body" +
+					" in `response_body_excerpt`. The `triggered_at` and `last_attempt_at` times are in RFC3339 format." +
+					" The `environment_id`, `workspace_id` and `run_id` refer to the objects the event relates to, if any.",
+				ElementType: webhookDeliveryElementType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *webhookDeliveriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg webhookDeliveriesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookID := cfg.WebhookID.ValueString()
+	opts := &webhook_integration_delivery.ListWebhookIntegrationDeliveriesOptions{
+		Filter:   map[string]string{"webhook": webhookID},
+		Sort:     []string{"-triggered-at"},
+		PageSize: 100,
+	}
+
+	id := "webhook-deliveries" + webhookID
+	if !cfg.Status.IsNull() {
+		id += cfg.Status.ValueString()
+		opts.Filter["status"] = cfg.Status.ValueString()
+	}
+	if !cfg.MaxResults.IsNull() {
+		id += cfg.MaxResults.String()
+		opts.PageSize = int(min(cfg.MaxResults.ValueInt64(), 100))
+	}
+
+	// The deliveries are requested newest first, so the paging stops as soon as enough deliveries are collected.
+	deliveries := make([]webhookDeliveryModel, 0)
+	for wd, err := range d.ClientV2.WebhookIntegrationDelivery.ListWebhookIntegrationDeliveriesIter(ctx, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving webhook deliveries", err.Error())
+			return
+		}
+
+		if wd.Relationships.Webhook != nil && wd.Relationships.Webhook.ID != webhookID {
+			continue
+		}
+		// The status is filtered by the API as well, this check only guards the result.
+		if !cfg.Status.IsNull() && wd.Attributes.Status != cfg.Status.ValueString() {
+			continue
+		}
+
+		deliveries = append(deliveries, newWebhookDeliveryModel(wd))
+		if !cfg.MaxResults.IsNull() && int64(len(deliveries)) >= cfg.MaxResults.ValueInt64() {
+			break
+		}
+	}
+
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		return deliveries[i].TriggeredAt.ValueString() > deliveries[j].TriggeredAt.ValueString()
+	})
+
+	ids := make([]string, len(deliveries))
+	for i, delivery := range deliveries {
+		ids[i] = delivery.Id.ValueString()
+	}
+
+	cfg.Id = types.StringValue(fmt.Sprintf("%d", framework.HashString(id)))
+
+	idsValue, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	cfg.IDs = idsValue
+
+	deliveriesValue, diags := types.ListValueFrom(ctx, webhookDeliveryElementType, deliveries)
+	resp.Diagnostics.Append(diags...)
+	cfg.Deliveries = deliveriesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
+
+func newWebhookDeliveryModel(wd schemas.WebhookIntegrationDelivery) webhookDeliveryModel {
+	delivery := webhookDeliveryModel{
+		Id:                  types.StringValue(wd.ID),
+		Event:               types.StringNull(),
+		Status:              types.StringValue(wd.Attributes.Status),
+		ResponseCode:        types.Int64Null(),
+		Attempts:            types.Int64Value(int64(wd.Attributes.Attempts)),
+		ErrorMessage:        types.StringPointerValue(wd.Attributes.ErrorMessage),
+		ResponseBodyExcerpt: types.StringNull(),
+		TriggeredAt:         types.StringValue(wd.Attributes.TriggeredAt.Format(time.RFC3339)),
+		LastAttemptAt:       types.StringNull(),
+		EnvironmentID:       types.StringNull(),
+		WorkspaceID:         types.StringNull(),
+		RunID:               types.StringNull(),
+	}
+	if wd.Attributes.ResponseCode != nil {
+		delivery.ResponseCode = types.Int64Value(int64(*wd.Attributes.ResponseCode))
+	}
+	if wd.Attributes.ResponseBody != nil {
+		if excerpt, ok := responseBodyExcerpt(*wd.Attributes.ResponseBody); ok {
+			delivery.ResponseBodyExcerpt = types.StringValue(excerpt)
+		}
+	}
+	if !wd.Attributes.LastHandleAttemptAt.IsZero() {
+		delivery.LastAttemptAt = types.StringValue(wd.Attributes.LastHandleAttemptAt.Format(time.RFC3339))
+	}
+	if wd.Relationships.Event != nil {
+		delivery.Event = types.StringValue(wd.Relationships.Event.ID)
+	}
+	if wd.Relationships.Environment != nil {
+		delivery.EnvironmentID = types.StringValue(wd.Relationships.Environment.ID)
+	}
+	if wd.Relationships.Workspace != nil {
+		delivery.WorkspaceID = types.StringValue(wd.Relationships.Workspace.ID)
+	}
+	if wd.Relationships.Run != nil {
+		delivery.RunID = types.StringValue(wd.Relationships.Run.ID)
+	}
+	return delivery
+}
+
+// responseBodyExcerpt returns the beginning of a response body. A plain text body is returned as is,
+// any other JSON value is encoded. The excerpt is cut at a rune boundary.
+func responseBodyExcerpt(body interface{}) (string, bool) {
+	var text string
+	switch v := body.(type) {
+	case nil:
+		return "", false
+	case string:
+		text = v
+	default:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		text = string(raw)
+	}
+
+	runes := []rune(text)
+	if len(runes) > webhookResponseExcerptLength {
+		runes = runes[:webhookResponseExcerptLength]
+	}
+	return string(runes), true
+}
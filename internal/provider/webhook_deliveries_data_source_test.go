@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrWebhookDeliveriesDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      `data scalr_webhook_deliveries test {}`,
+				ExpectError: regexp.MustCompile(`The argument "webhook_id" is required`),
+				PlanOnly:    true,
+			},
+			{
+				Config: `
+data scalr_webhook_deliveries test {
+  webhook_id = "wh-123"
+  status     = "unknown"
+}`,
+				ExpectError: regexp.MustCompile(`Attribute status value must be one of`),
+				PlanOnly:    true,
+			},
+			{
+				Config: testAccScalrWebhookDeliveriesDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_webhook_deliveries.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_webhook_deliveries.test", "webhook_id",
+						"scalr_webhook.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.scalr_webhook_deliveries.test", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.scalr_webhook_deliveries.test", "deliveries.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestResponseBodyExcerpt(t *testing.T) {
+	long := strings.Repeat("é", webhookResponseExcerptLength+10)
+
+	for name, tc := range map[string]struct {
+		body interface{}
+		want string
+		ok   bool
+	}{
+		"nil":    {body: nil, ok: false},
+		"text":   {body: "OK", want: "OK", ok: true},
+		"json":   {body: map[string]interface{}{"accepted": true}, want: `{"accepted":true}`, ok: true},
+		"number": {body: float64(42), want: "42", ok: true},
+		"long":   {body: long, want: long[:2*webhookResponseExcerptLength], ok: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, ok := responseBodyExcerpt(tc.body)
+			if ok != tc.ok || got != tc.want {
+				t.Errorf("expected (%q, %t), got (%q, %t)", tc.want, tc.ok, got, ok)
+			}
+		})
+	}
+}
+
+func testAccScalrWebhookDeliveriesDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource scalr_webhook test {
+  name       = "webhook-deliveries-%d"
+  enabled    = false
+  events     = ["run:completed"]
+  url        = "https://example.com/webhook"
+  account_id = "%s"
+}
+
+data scalr_webhook_deliveries test {
+  webhook_id  = scalr_webhook.test.id
+  status      = "failed"
+  max_results = 10
+}`, rInt, defaultAccount)
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/ops/webhook_integration"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/defaults"
+)
+
+// Compile-time interface checks
+var (
+	_ datasource.DataSource              = &webhooksDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhooksDataSource{}
+)
+
+func newWebhooksDataSource() datasource.DataSource {
+	return &webhooksDataSource{}
+}
+
+// webhooksDataSource defines the data source implementation.
+type webhooksDataSource struct {
+	framework.DataSourceWithScalrClient
+}
+
+// webhooksDataSourceModel describes the data source data model.
+type webhooksDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	AccountID     types.String `tfsdk:"account_id"`
+	IDs           types.Set    `tfsdk:"ids"`
+}
+
+func (d *webhooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks"
+}
+
+func (d *webhooksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a list of webhook ids by name or environment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of this data source.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The query used in a Scalr webhook name filter.",
+				Optional:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment, in the format `env-<RANDOM STRING>`. Only return webhooks that are shared to this environment.",
+				Optional:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Scalr account, in the format `acc-<RANDOM STRING>`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "The list of webhook IDs, in the format [`wh-xxxxxxxxxxx`, `wh-yyyyyyyyy`].",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *webhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var cfg webhooksDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var accID string
+	if !cfg.AccountID.IsNull() {
+		accID = cfg.AccountID.ValueString()
+	} else {
+		var diags diag.Diagnostics
+		accID, diags = defaults.GetDefaultScalrAccountID()
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := strings.Builder{} // holds the string to build a unique resource id hash
+	id.WriteString(accID)
+
+	opts := &webhook_integration.ListWebhookIntegrationsOptions{
+		Filter:   map[string]string{"account": accID},
+		PageSize: 100,
+	}
+
+	if !cfg.Name.IsNull() {
+		id.WriteString(cfg.Name.ValueString())
+		opts.Filter["name"] = cfg.Name.ValueString()
+	}
+
+	if !cfg.EnvironmentID.IsNull() {
+		id.WriteString(cfg.EnvironmentID.ValueString())
+	}
+
+	ids := make([]string, 0)
+	for item, err := range d.ClientV2.WebhookIntegration.ListWebhookIntegrationsIter(ctx, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving webhooks", err.Error())
+			return
+		}
+
+		if !cfg.EnvironmentID.IsNull() && !item.Attributes.IsShared &&
+			!environmentsContain(item.Relationships.Environments, cfg.EnvironmentID.ValueString()) {
+			continue
+		}
+
+		ids = append(ids, item.ID)
+	}
+	sort.Strings(ids)
+
+	cfg.Id = types.StringValue(fmt.Sprintf("%d", framework.HashString(id.String())))
+	cfg.AccountID = types.StringValue(accID)
+
+	idsValue, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	cfg.IDs = idsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cfg)...)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScalrWebhooksDataSource_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWebhooksDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scalr_webhooks.test", "id"),
+					resource.TestCheckResourceAttr("data.scalr_webhooks.test", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.scalr_webhooks.env", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_webhooks.env", "ids.0",
+						"scalr_webhook.shared", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccScalrWebhooksDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource scalr_environment test {
+  name       = "test-env-webhooks-%d"
+  account_id = "%s"
+}
+
+resource scalr_webhook shared {
+  name         = "webhooks-%[1]d-shared"
+  enabled      = false
+  events       = ["run:completed"]
+  url          = "https://example.com/webhook"
+  account_id   = "%[2]s"
+  environments = [scalr_environment.test.id]
+}
+
+resource scalr_webhook private {
+  name       = "webhooks-%[1]d-private"
+  enabled    = false
+  events     = ["run:completed"]
+  url        = "https://example.com/webhook"
+  account_id = "%[2]s"
+}
+
+data scalr_webhooks test {
+  name       = "like:webhooks-%[1]d-"
+  account_id = "%[2]s"
+  depends_on = [scalr_webhook.shared, scalr_webhook.private]
+}
+
+data scalr_webhooks env {
+  name           = "like:webhooks-%[1]d-"
+  environment_id = scalr_environment.test.id
+  account_id     = "%[2]s"
+  depends_on     = [scalr_webhook.shared, scalr_webhook.private]
+}`, rInt, defaultAccount)
+}
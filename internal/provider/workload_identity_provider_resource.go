@@ -111,7 +111,7 @@ func (r *workloadIdentityProviderResource) Create(ctx context.Context, req resou
 
 	provider, err := r.Client.WorkloadIdentityProviders.Create(ctx, opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating workload identity provider", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error creating workload identity provider", err)...)
 		return
 	}
 
@@ -185,7 +185,7 @@ func (r *workloadIdentityProviderResource) Update(ctx context.Context, req resou
 
 	provider, err := r.Client.WorkloadIdentityProviders.Update(ctx, plan.ID.ValueString(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating workload identity provider", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error updating workload identity provider", err)...)
 		return
 	}
 
@@ -213,7 +213,7 @@ func (r *workloadIdentityProviderResource) Delete(ctx context.Context, req resou
 
 	err := r.Client.WorkloadIdentityProviders.Delete(ctx, state.ID.ValueString())
 	if err != nil && !errors.Is(err, scalr.ErrResourceNotFound) {
-		resp.Diagnostics.AddError("Error deleting workload identity provider", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting workload identity provider", err)...)
 		return
 	}
 }
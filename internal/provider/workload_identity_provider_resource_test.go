@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/scalr/go-scalr"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccScalrWorkloadIdentityProvider_basic(t *testing.T) {
@@ -105,7 +107,7 @@ resource "scalr_workload_identity_provider" "test" {
 
 func testAccCheckScalrWorkloadIdentityProviderExists(resId string, provider *scalr.WorkloadIdentityProvider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		rs, ok := s.RootModule().Resources[resId]
 		if !ok {
@@ -128,7 +130,7 @@ func testAccCheckScalrWorkloadIdentityProviderExists(resId string, provider *sca
 }
 
 func testAccCheckScalrWorkloadIdentityProviderDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_workload_identity_provider" {
@@ -61,12 +61,16 @@ type workspaceResourceModel struct {
 	HasResources              types.Bool   `tfsdk:"has_resources"`
 	Hooks                     types.List   `tfsdk:"hooks"`
 	IaCPlatform               types.String `tfsdk:"iac_platform"`
+	ManagePcfgs               types.Bool   `tfsdk:"manage_provider_configurations"`
+	ManageSSHKey              types.Bool   `tfsdk:"manage_ssh_key"`
+	ManageTags                types.Bool   `tfsdk:"manage_tags"`
 	ModuleVersionID           types.String `tfsdk:"module_version_id"`
 	Name                      types.String `tfsdk:"name"`
 	Operations                types.Bool   `tfsdk:"operations"`
 	ProviderConfiguration     types.Set    `tfsdk:"provider_configuration"`
 	RemoteBackend             types.Bool   `tfsdk:"remote_backend"`
 	RemoteStateConsumers      types.Set    `tfsdk:"remote_state_consumers"`
+	RemoteStateSharing        types.Bool   `tfsdk:"remote_state_sharing"`
 	RunOperationTimeout       types.Int32  `tfsdk:"run_operation_timeout"`
 	SSHKeyID                  types.String `tfsdk:"ssh_key_id"`
 	TagIDs                    types.Set    `tfsdk:"tag_ids"`
@@ -131,12 +135,16 @@ func workspaceResourceModelFromAPI(
 		HasResources:              types.BoolValue(ws.Attributes.HasResources),
 		Hooks:                     types.ListNull(hooksElementType),
 		IaCPlatform:               types.StringValue(string(ws.Attributes.IacPlatform)),
+		ManagePcfgs:               types.BoolValue(true),
+		ManageSSHKey:              types.BoolValue(true),
+		ManageTags:                types.BoolValue(true),
 		ModuleVersionID:           types.StringNull(),
 		Name:                      types.StringValue(ws.Attributes.Name),
 		Operations:                types.BoolValue(ws.Attributes.Operations),
 		ProviderConfiguration:     types.SetNull(providerConfigurationElementType),
 		RemoteBackend:             types.BoolValue(ws.Attributes.RemoteBackend),
 		RemoteStateConsumers:      types.SetNull(types.StringType),
+		RemoteStateSharing:        types.BoolValue(ws.Attributes.RemoteStateSharing),
 		RunOperationTimeout:       types.Int32Null(),
 		SSHKeyID:                  types.StringNull(),
 		TagIDs:                    types.SetNull(types.StringType),
@@ -240,15 +248,29 @@ func workspaceResourceModelFromAPI(
 		model.Hooks = hooksValue
 	}
 
-	tags := make([]string, len(ws.Relationships.Tags))
-	for i, tag := range ws.Relationships.Tags {
-		tags[i] = tag.ID
+	if existing != nil && !existing.ManagePcfgs.IsNull() {
+		model.ManagePcfgs = existing.ManagePcfgs
+	}
+	if existing != nil && !existing.ManageSSHKey.IsNull() {
+		model.ManageSSHKey = existing.ManageSSHKey
+	}
+	if existing != nil && !existing.ManageTags.IsNull() {
+		model.ManageTags = existing.ManageTags
+	}
+
+	// Tags of an unmanaged workspace are left out, as they are not configured in `tag_ids`.
+	tags := make([]string, 0, len(ws.Relationships.Tags))
+	if model.ManageTags.ValueBool() {
+		for _, tag := range ws.Relationships.Tags {
+			tags = append(tags, tag.ID)
+		}
 	}
 	tagsValue, d := types.SetValueFrom(ctx, types.StringType, tags)
 	diags.Append(d...)
 	model.TagIDs = tagsValue
 
-	if len(pcfgLinks) > 0 {
+	// Links of an unmanaged workspace are left out, as they are not configured with `provider_configuration` blocks.
+	if model.ManagePcfgs.ValueBool() && len(pcfgLinks) > 0 {
 		pcfg := make([]providerConfigurationModel, len(pcfgLinks))
 		for i, pcfgLink := range pcfgLinks {
 			pcfg[i] = providerConfigurationModel{
@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+	"github.com/scalr/go-scalr/v2/scalr/value"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ resource.Resource                = &workspaceProviderConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &workspaceProviderConfigurationResource{}
+	_ resource.ResourceWithImportState = &workspaceProviderConfigurationResource{}
+)
+
+func newWorkspaceProviderConfigurationResource() resource.Resource {
+	return &workspaceProviderConfigurationResource{}
+}
+
+// workspaceProviderConfigurationResource defines the resource implementation.
+type workspaceProviderConfigurationResource struct {
+	framework.ResourceWithScalrClient
+}
+
+// workspaceProviderConfigurationResourceModel describes the resource data model.
+type workspaceProviderConfigurationResourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	WorkspaceID             types.String `tfsdk:"workspace_id"`
+	ProviderConfigurationID types.String `tfsdk:"provider_configuration_id"`
+	Alias                   types.String `tfsdk:"alias"`
+}
+
+func workspaceProviderConfigurationResourceModelFromAPI(
+	link *schemas.ProviderConfigurationLink,
+) *workspaceProviderConfigurationResourceModel {
+	model := &workspaceProviderConfigurationResourceModel{
+		Id:                      types.StringValue(link.ID),
+		WorkspaceID:             types.StringNull(),
+		ProviderConfigurationID: types.StringNull(),
+		Alias:                   types.StringNull(),
+	}
+
+	if link.Relationships.Workspace != nil {
+		model.WorkspaceID = types.StringValue(link.Relationships.Workspace.ID)
+	}
+	if link.Relationships.ProviderConfiguration != nil {
+		model.ProviderConfigurationID = types.StringValue(link.Relationships.ProviderConfiguration.ID)
+	}
+	if link.Attributes.Alias != nil && *link.Attributes.Alias != "" {
+		model.Alias = types.StringValue(*link.Attributes.Alias)
+	}
+
+	return model
+}
+
+// workspaceProviderConfigurationAPIErrorPaths maps the API error sources of the workspace provider configuration to its attributes.
+var workspaceProviderConfigurationAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/alias":                     path.Root("alias"),
+	"/data/relationships/provider-configuration": path.Root("provider_configuration_id"),
+}
+
+func (r *workspaceProviderConfigurationResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_provider_configuration"
+}
+
+func (r *workspaceProviderConfigurationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the link between a provider configuration and a workspace in Scalr." +
+			" Use it when the provider configurations and the workspaces are managed in different configurations." +
+			"\n\n~> **Note:** Set `manage_provider_configurations = false` on the `scalr_workspace` resource" +
+			" of the linked workspace, otherwise it will detach the provider configurations linked with this resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the provider configuration link.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace, in the format `ws-<RANDOM STRING>`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"provider_configuration_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the provider configuration, in the format `pcfg-<RANDOM STRING>`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"alias": schema.StringAttribute{
+				MarkdownDescription: "The alias of the provider configuration in the workspace. Use it to link" +
+					" several configurations of the same provider to one workspace.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *workspaceProviderConfigurationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
+	var plan workspaceProviderConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := schemas.ProviderConfigurationLinkRequest{
+		Relationships: schemas.ProviderConfigurationLinkRelationshipsRequest{
+			ProviderConfiguration: value.Set(schemas.ProviderConfiguration{ID: plan.ProviderConfigurationID.ValueString()}),
+		},
+	}
+	if !plan.Alias.IsNull() {
+		opts.Attributes.Alias = value.Set(plan.Alias.ValueString())
+	}
+
+	link, err := r.ClientV2.ProviderConfigurationLink.CreateProviderConfigurationLink(
+		ctx, plan.WorkspaceID.ValueString(), &opts,
+	)
+	if err != nil {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error creating provider configuration link", err,
+			framework.WithAttributePaths(workspaceProviderConfigurationAPIErrorPaths),
+			framework.WithRequiredPermission("workspaces:update"),
+		)...)
+		return
+	}
+
+	plan.Id = types.StringValue(link.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *workspaceProviderConfigurationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state workspaceProviderConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, err := r.ClientV2.ProviderConfigurationLink.GetProviderConfigurationLink(ctx, state.Id.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving provider configuration link", err.Error())
+		return
+	}
+
+	result := workspaceProviderConfigurationResourceModelFromAPI(link)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *workspaceProviderConfigurationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
+	var plan, state workspaceProviderConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := schemas.ProviderConfigurationLinkRequest{}
+	if !plan.Alias.Equal(state.Alias) {
+		if plan.Alias.IsNull() {
+			opts.Attributes.Alias = value.Null[string]()
+		} else {
+			opts.Attributes.Alias = value.Set(plan.Alias.ValueString())
+		}
+	}
+
+	_, err := r.ClientV2.ProviderConfigurationLink.UpdateProviderConfigurationLink(ctx, plan.Id.ValueString(), &opts)
+	if err != nil {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error updating provider configuration link", err,
+			framework.WithAttributePaths(workspaceProviderConfigurationAPIErrorPaths),
+			framework.WithRequiredPermission("workspaces:update"),
+		)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *workspaceProviderConfigurationResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
+	var state workspaceProviderConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.ClientV2.ProviderConfigurationLink.DeleteProviderConfigurationWorkspaceLink(ctx, state.Id.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error deleting provider configuration link", err,
+			framework.WithRequiredPermission("workspaces:update"),
+		)...)
+		return
+	}
+}
+
+// ImportState handles importing existing resources into Terraform state.
+//
+// In addition to importing by the link ID, it is also possible to import the link
+// in the format '<workspace_id>/<provider_configuration_id>' or
+// '<workspace_id>/<provider_configuration_id>/<alias>'.
+func (r *workspaceProviderConfigurationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	if !strings.Contains(req.ID, "/") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf(
+				"Expected import ID in the format <workspace_id>/<provider_configuration_id>[/<alias>], got: %q",
+				req.ID,
+			),
+		)
+		return
+	}
+	workspaceID, pcfgID := parts[0], parts[1]
+	var alias string
+	if len(parts) == 3 {
+		alias = parts[2]
+	}
+
+	links, err := getProviderConfigurationWorkspaceLinks(ctx, r.ClientV2, workspaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving provider configuration links", err.Error())
+		return
+	}
+
+	for _, link := range links {
+		if link.Relationships.ProviderConfiguration == nil || link.Relationships.ProviderConfiguration.ID != pcfgID {
+			continue
+		}
+		if derefString(link.Attributes.Alias) != alias {
+			continue
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), link.ID)...)
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Error importing provider configuration link",
+		fmt.Sprintf("Provider configuration %s is not linked to workspace %s.", pcfgID, workspaceID),
+	)
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+)
+
+func TestAccScalrWorkspaceProviderConfiguration_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrWorkspaceProviderConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWorkspaceProviderConfigurationConfig(rInt, `alias = "dev"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("scalr_workspace_provider_configuration.default", "id"),
+					resource.TestCheckResourceAttrPair(
+						"scalr_workspace_provider_configuration.default", "workspace_id",
+						"scalr_workspace.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"scalr_workspace_provider_configuration.default", "provider_configuration_id",
+						"scalr_provider_configuration.kubernetes", "id",
+					),
+					resource.TestCheckNoResourceAttr("scalr_workspace_provider_configuration.default", "alias"),
+					resource.TestCheckResourceAttr("scalr_workspace_provider_configuration.aliased", "alias", "dev"),
+				),
+			},
+			{
+				// The workspace doesn't manage the links, so the ones created by the link resource cause no drift.
+				Config: testAccScalrWorkspaceProviderConfigurationConfig(rInt, `alias = "staging"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_workspace_provider_configuration.aliased", "alias", "staging"),
+					resource.TestCheckResourceAttr("scalr_workspace.test", "provider_configuration.#", "0"),
+				),
+			},
+			{
+				Config:   testAccScalrWorkspaceProviderConfigurationConfig(rInt, `alias = "staging"`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccScalrWorkspaceProviderConfiguration_removeAlias(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrWorkspaceProviderConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWorkspaceProviderConfigurationSingleConfig(rInt, `alias = "dev"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_workspace_provider_configuration.test", "alias", "dev"),
+				),
+			},
+			{
+				Config: testAccScalrWorkspaceProviderConfigurationSingleConfig(rInt, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("scalr_workspace_provider_configuration.test", "alias"),
+				),
+			},
+			{
+				Config:   testAccScalrWorkspaceProviderConfigurationSingleConfig(rInt, ""),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccScalrWorkspaceProviderConfiguration_import(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrWorkspaceProviderConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWorkspaceProviderConfigurationConfig(rInt, `alias = "dev"`),
+			},
+			{
+				ResourceName:      "scalr_workspace_provider_configuration.aliased",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName: "scalr_workspace_provider_configuration.aliased",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["scalr_workspace_provider_configuration.aliased"]
+					return fmt.Sprintf(
+						"%s/%s/dev", rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["provider_configuration_id"],
+					), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScalrWorkspaceProviderConfigurationDestroy(s *terraform.State) error {
+	scalrClient := createScalrClientV2()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "scalr_workspace_provider_configuration" {
+			continue
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no instance ID is set")
+		}
+
+		_, err := scalrClient.ProviderConfigurationLink.GetProviderConfigurationLink(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("provider configuration link %s still exists", rs.Primary.ID)
+		}
+		if !errors.Is(err, client.ErrNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccScalrWorkspaceProviderConfigurationConfig(rInt int, alias string) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name       = "test-env-%d"
+  account_id = "%s"
+}
+
+resource "scalr_workspace" "test" {
+  name                           = "test-ws-%[1]d"
+  environment_id                 = scalr_environment.test.id
+  manage_provider_configurations = false
+}
+
+resource "scalr_provider_configuration" "kubernetes" {
+  name         = "kubernetes-%[1]d"
+  account_id   = scalr_environment.test.account_id
+  environments = [scalr_environment.test.id]
+  custom {
+    provider_name = "kubernetes"
+    argument {
+      name  = "config_path"
+      value = "~/.kube/config"
+    }
+  }
+}
+
+resource "scalr_workspace_provider_configuration" "default" {
+  workspace_id              = scalr_workspace.test.id
+  provider_configuration_id = scalr_provider_configuration.kubernetes.id
+}
+
+resource "scalr_workspace_provider_configuration" "aliased" {
+  workspace_id              = scalr_workspace.test.id
+  provider_configuration_id = scalr_provider_configuration.kubernetes.id
+  %[3]s
+}
+`, rInt, defaultAccount, alias)
+}
+
+func testAccScalrWorkspaceProviderConfigurationSingleConfig(rInt int, alias string) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name       = "test-env-%d"
+  account_id = "%s"
+}
+
+resource "scalr_workspace" "test" {
+  name                           = "test-ws-%[1]d"
+  environment_id                 = scalr_environment.test.id
+  manage_provider_configurations = false
+}
+
+resource "scalr_provider_configuration" "kubernetes" {
+  name         = "kubernetes-%[1]d"
+  account_id   = scalr_environment.test.account_id
+  environments = [scalr_environment.test.id]
+  custom {
+    provider_name = "kubernetes"
+    argument {
+      name  = "config_path"
+      value = "~/.kube/config"
+    }
+  }
+}
+
+resource "scalr_workspace_provider_configuration" "test" {
+  workspace_id              = scalr_workspace.test.id
+  provider_configuration_id = scalr_provider_configuration.kubernetes.id
+  %[3]s
+}
+`, rInt, defaultAccount, alias)
+}
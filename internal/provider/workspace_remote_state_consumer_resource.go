@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ resource.Resource                = &workspaceRemoteStateConsumerResource{}
+	_ resource.ResourceWithConfigure   = &workspaceRemoteStateConsumerResource{}
+	_ resource.ResourceWithImportState = &workspaceRemoteStateConsumerResource{}
+)
+
+func newWorkspaceRemoteStateConsumerResource() resource.Resource {
+	return &workspaceRemoteStateConsumerResource{}
+}
+
+// workspaceRemoteStateConsumerResource defines the resource implementation.
+type workspaceRemoteStateConsumerResource struct {
+	framework.ResourceWithScalrClient
+}
+
+// workspaceRemoteStateConsumerResourceModel describes the resource data model.
+type workspaceRemoteStateConsumerResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	WorkspaceID         types.String `tfsdk:"workspace_id"`
+	ConsumerWorkspaceID types.String `tfsdk:"consumer_workspace_id"`
+}
+
+func (r *workspaceRemoteStateConsumerResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_remote_state_consumer"
+}
+
+func (r *workspaceRemoteStateConsumerResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Allows a workspace to access the state of another workspace in Scalr." +
+			" Unlike `scalr_workspace.remote_state_consumers`, this resource is non-authoritative:" +
+			" it manages a single consumer, so it can be declared in the configuration of the consumer workspace." +
+			"\n\n~> **Note:** The state of the workspace must not be shared with the whole environment," +
+			" see the `remote_state_sharing` attribute of the `scalr_workspace` resource." +
+			" Do not configure `remote_state_consumers` on the `scalr_workspace` resource together with this resource" +
+			" for the same workspace, otherwise they will conflict over the consumers.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource, in the format `<workspace_id>/<consumer_workspace_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace that shares its state, in the format `ws-<RANDOM STRING>`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"consumer_workspace_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace that is allowed to read the state, in the format `ws-<RANDOM STRING>`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *workspaceRemoteStateConsumerResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
+	var plan workspaceRemoteStateConsumerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := plan.WorkspaceID.ValueString()
+	consumerID := plan.ConsumerWorkspaceID.ValueString()
+
+	err := r.ClientV2.Workspace.AddRemoteStateConsumers(ctx, workspaceID, []schemas.Workspace{{ID: consumerID}})
+	if err != nil {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error adding remote state consumer", err,
+			framework.WithRequiredPermission("workspaces:update"),
+		)...)
+		return
+	}
+
+	plan.Id = types.StringValue(workspaceID + "/" + consumerID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *workspaceRemoteStateConsumerResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state workspaceRemoteStateConsumerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := state.WorkspaceID.ValueString()
+	consumerID := state.ConsumerWorkspaceID.ValueString()
+
+	for consumer, err := range r.ClientV2.Workspace.ListRemoteStateConsumersIter(ctx, workspaceID, nil) {
+		if err != nil {
+			if errors.Is(err, client.ErrNotFound) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error reading remote state consumers for workspace %s", workspaceID),
+				err.Error(),
+			)
+			return
+		}
+		if consumer.ID == consumerID {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	// The consumer was removed outside of Terraform.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *workspaceRemoteStateConsumerResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+	// Not updatable - any attribute change forces recreate.
+}
+
+func (r *workspaceRemoteStateConsumerResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
+	var state workspaceRemoteStateConsumerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.ClientV2.Workspace.DeleteRemoteStateConsumers(
+		ctx,
+		state.WorkspaceID.ValueString(),
+		[]schemas.Workspace{{ID: state.ConsumerWorkspaceID.ValueString()}},
+	)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error removing remote state consumer", err,
+			framework.WithRequiredPermission("workspaces:update"),
+		)...)
+		return
+	}
+}
+
+func (r *workspaceRemoteStateConsumerResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected import ID in the format <workspace_id>/<consumer_workspace_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("consumer_workspace_id"), parts[1])...)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccScalrWorkspaceRemoteStateConsumer_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrWorkspaceRemoteStateConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWorkspaceRemoteStateConsumerConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrWorkspaceRemoteStateConsumerExists("scalr_workspace_remote_state_consumer.app"),
+					testAccCheckScalrWorkspaceRemoteStateConsumerExists("scalr_workspace_remote_state_consumer.db"),
+					resource.TestCheckResourceAttrPair(
+						"scalr_workspace_remote_state_consumer.app", "workspace_id",
+						"scalr_workspace.network", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"scalr_workspace_remote_state_consumer.app", "consumer_workspace_id",
+						"scalr_workspace.app", "id",
+					),
+					resource.TestCheckResourceAttr("scalr_workspace.network", "remote_state_sharing", "false"),
+				),
+			},
+			{
+				// The workspace doesn't manage its consumers, so the added ones cause no drift.
+				Config: testAccScalrWorkspaceRemoteStateConsumerConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_workspace.network", "remote_state_consumers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccScalrWorkspaceRemoteStateConsumer_import(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrWorkspaceRemoteStateConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWorkspaceRemoteStateConsumerConfig(rInt),
+			},
+			{
+				ResourceName:      "scalr_workspace_remote_state_consumer.app",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScalrWorkspaceRemoteStateConsumerExists(resID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		scalrClient := createScalrClientV2()
+
+		rs, ok := s.RootModule().Resources[resID]
+		if !ok {
+			return fmt.Errorf("not found: %s", resID)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no instance ID is set")
+		}
+
+		parts := strings.SplitN(rs.Primary.ID, "/", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid ID format: %s", rs.Primary.ID)
+		}
+		workspaceID, consumerID := parts[0], parts[1]
+
+		consumers, err := getRemoteStateConsumers(ctx, scalrClient, workspaceID)
+		if err != nil {
+			return fmt.Errorf("error listing remote state consumers for workspace %s: %w", workspaceID, err)
+		}
+
+		for _, consumer := range consumers {
+			if consumer == consumerID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("workspace %s is not a remote state consumer of workspace %s", consumerID, workspaceID)
+	}
+}
+
+func testAccCheckScalrWorkspaceRemoteStateConsumerDestroy(s *terraform.State) error {
+	scalrClient := createScalrClientV2()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "scalr_workspace_remote_state_consumer" {
+			continue
+		}
+		if rs.Primary.ID == "" {
+			continue
+		}
+
+		parts := strings.SplitN(rs.Primary.ID, "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		workspaceID, consumerID := parts[0], parts[1]
+
+		consumers, err := getRemoteStateConsumers(ctx, scalrClient, workspaceID)
+		if err != nil {
+			// Workspace may already be deleted — treat as success.
+			continue
+		}
+
+		for _, consumer := range consumers {
+			if consumer == consumerID {
+				return fmt.Errorf("workspace %s is still a remote state consumer of workspace %s", consumerID, workspaceID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccScalrWorkspaceRemoteStateConsumerConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name       = "test-env-%d"
+  account_id = "%s"
+}
+
+resource "scalr_workspace" "network" {
+  name                 = "test-ws-network-%[1]d"
+  environment_id       = scalr_environment.test.id
+  remote_state_sharing = false
+}
+
+resource "scalr_workspace" "app" {
+  name           = "test-ws-app-%[1]d"
+  environment_id = scalr_environment.test.id
+}
+
+resource "scalr_workspace" "db" {
+  name           = "test-ws-db-%[1]d"
+  environment_id = scalr_environment.test.id
+}
+
+resource "scalr_workspace_remote_state_consumer" "app" {
+  workspace_id          = scalr_workspace.network.id
+  consumer_workspace_id = scalr_workspace.app.id
+}
+
+resource "scalr_workspace_remote_state_consumer" "db" {
+  workspace_id          = scalr_workspace.network.id
+  consumer_workspace_id = scalr_workspace.db.id
+}
+`, rInt, defaultAccount)
+}
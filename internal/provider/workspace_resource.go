@@ -40,6 +40,32 @@ type workspaceResource struct {
 	framework.ResourceWithScalrClient
 }
 
+// workspaceAPIErrorPaths maps the API error sources of the workspace to its attributes.
+var workspaceAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/name":                        path.Root("name"),
+	"/data/attributes/auto-apply":                  path.Root("auto_apply"),
+	"/data/attributes/auto-queue-runs":             path.Root("auto_queue_runs"),
+	"/data/attributes/deletion-protection-enabled": path.Root("deletion_protection_enabled"),
+	"/data/attributes/execution-mode":              path.Root("execution_mode"),
+	"/data/attributes/force-latest-run":            path.Root("force_latest_run"),
+	"/data/attributes/hooks":                       path.Root("hooks"),
+	"/data/attributes/iac-platform":                path.Root("iac_platform"),
+	"/data/attributes/operations":                  path.Root("operations"),
+	"/data/attributes/remote-backend":              path.Root("remote_backend"),
+	"/data/attributes/remote-state-sharing":        path.Root("remote_state_sharing"),
+	"/data/attributes/run-operation-timeout":       path.Root("run_operation_timeout"),
+	"/data/attributes/terraform-version":           path.Root("terraform_version"),
+	"/data/attributes/terragrunt":                  path.Root("terragrunt"),
+	"/data/attributes/var-files":                   path.Root("var_files"),
+	"/data/attributes/vcs-repo":                    path.Root("vcs_repo"),
+	"/data/attributes/working-directory":           path.Root("working_directory"),
+	"/data/relationships/agent-pool":               path.Root("agent_pool_id"),
+	"/data/relationships/environment":              path.Root("environment_id"),
+	"/data/relationships/module-version":           path.Root("module_version_id"),
+	"/data/relationships/tags":                     path.Root("tag_ids"),
+	"/data/relationships/vcs-provider":             path.Root("vcs_provider_id"),
+}
+
 func (r *workspaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workspace"
 }
@@ -66,6 +92,8 @@ func (r *workspaceResource) ConfigValidators(_ context.Context) []resource.Confi
 }
 
 func (r *workspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
 	var plan workspaceResourceModel
 
 	// Read plan data
@@ -206,7 +234,7 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 		}
 	}
 
-	if !plan.TagIDs.IsUnknown() && !plan.TagIDs.IsNull() {
+	if plan.ManageTags.ValueBool() && !plan.TagIDs.IsUnknown() && !plan.TagIDs.IsNull() {
 		var tagIDs []string
 		resp.Diagnostics.Append(plan.TagIDs.ElementsAs(ctx, &tagIDs, false)...)
 
@@ -231,6 +259,8 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 				remoteStateConsumers = append(remoteStateConsumers, schemas.Workspace{ID: consumerID})
 			}
 		}
+	} else if !plan.RemoteStateSharing.IsUnknown() && !plan.RemoteStateSharing.IsNull() {
+		opts.Attributes.RemoteStateSharing = value.Set(plan.RemoteStateSharing.ValueBool())
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -239,11 +269,15 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 
 	ws, err := r.ClientV2.Workspace.CreateWorkspace(ctx, &opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating workspace", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error creating workspace", err,
+			framework.WithRequiredPermission("workspaces:create"),
+			framework.WithAttributePaths(workspaceAPIErrorPaths),
+		)...)
 		return
 	}
 
-	if !plan.ProviderConfiguration.IsUnknown() && !plan.ProviderConfiguration.IsNull() {
+	if plan.ManagePcfgs.ValueBool() && !plan.ProviderConfiguration.IsUnknown() && !plan.ProviderConfiguration.IsNull() {
 		var pcfgs []providerConfigurationModel
 		resp.Diagnostics.Append(plan.ProviderConfiguration.ElementsAs(ctx, &pcfgs, false)...)
 		if resp.Diagnostics.HasError() {
@@ -285,7 +319,7 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 			&schemas.WorkspaceSSHKeyLinkRequest{SshKey: plan.SSHKeyID.ValueString()},
 		)
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating SSH key link", err.Error())
+			resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error creating SSH key link", err)...)
 			return
 		}
 	}
@@ -324,6 +358,10 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !plan.ManageSSHKey.ValueBool() && !plan.SSHKeyID.IsUnknown() {
+		// Keep the planned SSH key, it may be relinked by other resources meanwhile.
+		result.SSHKeyID = plan.SSHKeyID
+	}
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
@@ -377,6 +415,8 @@ func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
 	var plan, state workspaceResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -558,7 +598,7 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	var consumersToAdd, consumersToRemove []string
-	if !plan.RemoteStateConsumers.Equal(state.RemoteStateConsumers) {
+	if !plan.RemoteStateConsumers.IsUnknown() && !plan.RemoteStateConsumers.Equal(state.RemoteStateConsumers) {
 		var planConsumers []string
 		var stateConsumers []string
 		resp.Diagnostics.Append(plan.RemoteStateConsumers.ElementsAs(ctx, &planConsumers, false)...)
@@ -575,6 +615,8 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 
 		consumersToAdd, consumersToRemove = diff(stateConsumers, planConsumers)
+	} else if !plan.RemoteStateSharing.IsUnknown() && !plan.RemoteStateSharing.Equal(state.RemoteStateSharing) {
+		opts.Attributes.RemoteStateSharing = value.Set(plan.RemoteStateSharing.ValueBool())
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -584,11 +626,15 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	// Update existing resource
 	_, err := r.ClientV2.Workspace.UpdateWorkspace(ctx, plan.Id.ValueString(), &opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating workspace", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error updating workspace", err,
+			framework.WithRequiredPermission("workspaces:update"),
+			framework.WithAttributePaths(workspaceAPIErrorPaths),
+		)...)
 		return
 	}
 
-	if !plan.TagIDs.Equal(state.TagIDs) {
+	if plan.ManageTags.ValueBool() && !plan.TagIDs.Equal(state.TagIDs) {
 		var planTags []string
 		var stateTags []string
 		resp.Diagnostics.Append(plan.TagIDs.ElementsAs(ctx, &planTags, false)...)
@@ -624,7 +670,7 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 	}
 
-	if !plan.ProviderConfiguration.Equal(state.ProviderConfiguration) {
+	if plan.ManagePcfgs.ValueBool() && !plan.ProviderConfiguration.Equal(state.ProviderConfiguration) {
 		expectedLinks := make(map[string]schemas.ProviderConfigurationLinkRequest)
 		if !plan.ProviderConfiguration.IsNull() {
 			var pcfgs []providerConfigurationModel
@@ -662,7 +708,7 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 						currentLink.ID,
 					)
 					if err != nil {
-						resp.Diagnostics.AddError("Error deleting provider configuration link", err.Error())
+						resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting provider configuration link", err)...)
 					}
 				}
 			}
@@ -674,17 +720,17 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 					&link,
 				)
 				if err != nil {
-					resp.Diagnostics.AddError("Error creating provider configuration link", err.Error())
+					resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error creating provider configuration link", err)...)
 				}
 			}
 		}
 	}
 
-	if !plan.SSHKeyID.Equal(state.SSHKeyID) {
+	if plan.ManageSSHKey.ValueBool() && !plan.SSHKeyID.Equal(state.SSHKeyID) {
 		if plan.SSHKeyID.IsNull() {
 			err = r.ClientV2.Misc.DeleteWorkspaceSshKeyLink(ctx, plan.Id.ValueString())
 			if err != nil {
-				resp.Diagnostics.AddError("Error deleting SSH key link", err.Error())
+				resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error deleting SSH key link", err)...)
 			}
 		} else {
 			_, err = r.ClientV2.Misc.CreateWorkspaceSshKeyLink(
@@ -693,7 +739,7 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 				},
 			)
 			if err != nil {
-				resp.Diagnostics.AddError("Error creating SSH key link", err.Error())
+				resp.Diagnostics.Append(framework.APIErrorDiagnostics("Error creating SSH key link", err)...)
 			}
 		}
 	}
@@ -745,6 +791,10 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !plan.ManageSSHKey.ValueBool() && !plan.SSHKeyID.IsUnknown() {
+		// Keep the planned SSH key, it may be relinked by other resources meanwhile.
+		result.SSHKeyID = plan.SSHKeyID
+	}
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
@@ -754,6 +804,8 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
 	// Get current state
 	var state workspaceResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -763,7 +815,10 @@ func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	err := r.ClientV2.Workspace.DeleteWorkspace(ctx, state.Id.ValueString())
 	if err != nil && !errors.Is(err, client.ErrNotFound) {
-		resp.Diagnostics.AddError("Error deleting workspace", err.Error())
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error deleting workspace", err,
+			framework.WithRequiredPermission("workspaces:delete"),
+		)...)
 		return
 	}
 }
@@ -824,6 +879,81 @@ func (r *workspaceResource) ModifyPlan(
 			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("operations"), true)...)
 		}
 	}
+
+	var consumersCfg types.Set
+	var sharingCfg types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("remote_state_consumers"), &consumersCfg)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("remote_state_sharing"), &sharingCfg)...)
+
+	if !consumersCfg.IsNull() {
+		// The consumers list is managed, and `remote_state_sharing` follows from it.
+		sharing := types.BoolUnknown()
+		if !consumersCfg.IsUnknown() {
+			var consumers []types.String
+			resp.Diagnostics.Append(consumersCfg.ElementsAs(ctx, &consumers, false)...)
+			if len(consumers) != 1 {
+				sharing = types.BoolValue(false)
+			} else if !consumers[0].IsUnknown() {
+				sharing = types.BoolValue(consumers[0].ValueString() == "*")
+			}
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("remote_state_sharing"), sharing)...)
+	} else if !sharingCfg.IsNull() && !req.State.Raw.IsNull() {
+		// The consumers list is not managed, but it reads as `["*"]` while the state is shared,
+		// so it is only known after apply when the sharing is toggled.
+		var sharingState types.Bool
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("remote_state_sharing"), &sharingState)...)
+		if !sharingCfg.Equal(sharingState) {
+			resp.Diagnostics.Append(
+				resp.Plan.SetAttribute(ctx, path.Root("remote_state_consumers"), types.SetUnknown(types.StringType))...,
+			)
+		}
+	}
+
+	var managePcfgs types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("manage_provider_configurations"), &managePcfgs)...)
+	if !managePcfgs.IsUnknown() && !managePcfgs.ValueBool() {
+		// The provider configuration links are not managed, e.g. they are created with `scalr_workspace_provider_configuration`.
+		var pcfgCfg types.Set
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("provider_configuration"), &pcfgCfg)...)
+		if !pcfgCfg.IsUnknown() && len(pcfgCfg.Elements()) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("provider_configuration"),
+				"Provider configurations are not managed",
+				"The `provider_configuration` blocks cannot be used while `manage_provider_configurations` is `false`.",
+			)
+		}
+	}
+
+	var manageTags types.Bool
+	var tagsCfg types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("manage_tags"), &manageTags)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tag_ids"), &tagsCfg)...)
+	if !manageTags.IsUnknown() && !manageTags.ValueBool() && !tagsCfg.IsNull() {
+		// The tags are not managed, e.g. they are attached with `scalr_workspace_tag`.
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tag_ids"),
+			"Tags are not managed",
+			"The `tag_ids` attribute cannot be set while `manage_tags` is `false`.",
+		)
+	}
+
+	var manageSSHKey types.Bool
+	var sshKeyCfg types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("manage_ssh_key"), &manageSSHKey)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ssh_key_id"), &sshKeyCfg)...)
+	if !manageSSHKey.IsUnknown() {
+		if manageSSHKey.ValueBool() && sshKeyCfg.IsNull() {
+			// The SSH key is managed, so omitting it unlinks the key from the workspace.
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ssh_key_id"), types.StringNull())...)
+		} else if !manageSSHKey.ValueBool() && !sshKeyCfg.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ssh_key_id"),
+				"SSH key is not managed",
+				"The `ssh_key_id` attribute cannot be set while `manage_ssh_key` is `false`.",
+			)
+		}
+	}
 }
 
 // ImportState handles importing existing resources into Terraform state.
@@ -15,6 +15,8 @@ import (
 	scalrV2 "github.com/scalr/go-scalr/v2/scalr"
 	"github.com/scalr/go-scalr/v2/scalr/ops/provider_configuration_link"
 	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 )
 
 func TestAccScalrWorkspaceResource_basic(t *testing.T) {
@@ -322,6 +324,22 @@ func TestAccScalrWorkspaceResource_providerConfiguration(t *testing.T) {
 						"scalr_workspace.test", "provider_configuration.#", "3"),
 				),
 			},
+			{
+				Config: testAccScalrWorkspaceProviderConfigurationRemoved(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrWorkspaceExists(
+						"scalr_workspace.test", workspace),
+					testAccCheckScalrWorkspaceProviderConfigurationsRemoved(workspace),
+					resource.TestCheckResourceAttr(
+						"scalr_workspace.test", "manage_provider_configurations", "true"),
+					resource.TestCheckResourceAttr(
+						"scalr_workspace.test", "provider_configuration.#", "0"),
+				),
+			},
+			{
+				Config:      testAccScalrWorkspaceProviderConfigurationUnmanaged(rInt),
+				ExpectError: regexp.MustCompile("Provider configurations are not managed"),
+			},
 		},
 	})
 }
@@ -364,7 +382,7 @@ func TestAccScalrWorkspaceResource_SSHKey(t *testing.T) {
 		CheckDestroy:             testAccCheckScalrWorkspaceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccScalrWorkspaceWithSSHKeyConfig(rInt, sshKeyName),
+				Config: testAccScalrWorkspaceWithSSHKeyConfig(rInt, sshKeyName, "ssh_key_id = scalr_ssh_key.test.id"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScalrWorkspaceExists("scalr_workspace.test", workspace),
 					testAccCheckScalrSSHKeyExists("scalr_ssh_key.test", sshKey),
@@ -373,6 +391,20 @@ func TestAccScalrWorkspaceResource_SSHKey(t *testing.T) {
 					resource.TestCheckResourceAttrSet("scalr_workspace.test", "ssh_key_id"),
 				),
 			},
+			{
+				Config: testAccScalrWorkspaceWithSSHKeyConfig(rInt, sshKeyName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrWorkspaceExists("scalr_workspace.test", workspace),
+					resource.TestCheckResourceAttr("scalr_workspace.test", "manage_ssh_key", "true"),
+					resource.TestCheckNoResourceAttr("scalr_workspace.test", "ssh_key_id"),
+				),
+			},
+			{
+				Config: testAccScalrWorkspaceWithSSHKeyConfig(
+					rInt, sshKeyName, "manage_ssh_key = false\n  ssh_key_id = scalr_ssh_key.test.id",
+				),
+				ExpectError: regexp.MustCompile("SSH key is not managed"),
+			},
 		},
 	})
 }
@@ -410,7 +442,7 @@ func TestAccScalrWorkspaceResource_StateConsumers(t *testing.T) {
 
 func testAccCheckScalrSSHKeyExists(n string, sshKey *scalr.SSHKey) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -537,7 +569,7 @@ func TestAccScalrWorkspaceResource_SCALRCORE_36088(t *testing.T) {
 func testAccCheckScalrWorkspaceExists(
 	n string, workspace *scalr.Workspace) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -679,6 +711,24 @@ func testAccCheckScalrWorkspaceProviderConfigurations(
 	}
 }
 
+func testAccCheckScalrWorkspaceProviderConfigurationsRemoved(
+	workspace *scalr.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		scalrClient := createScalrClientV2()
+
+		links, err := getProviderConfigurationWorkspaceLinksWithPcfg(ctx, scalrClient, workspace.ID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving provider configuration links: %v", err)
+		}
+
+		if len(links) != 0 {
+			return fmt.Errorf("Bad provider configurations: %v", links)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckScalrWorkspaceProviderConfigurationsUpdated(
 	workspace *scalr.Workspace) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -737,7 +787,7 @@ func testAccCheckScalrWorkspaceProviderConfigurationsUpdated(
 func testAccCheckScalrWorkspaceStateSharing(
 	n string, isShared bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+		scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -763,7 +813,7 @@ func testAccCheckScalrWorkspaceStateSharing(
 }
 
 func testAccCheckScalrWorkspaceDestroy(s *terraform.State) error {
-	scalrClient := testAccProviderSDK.Meta().(*scalr.Client)
+	scalrClient := testAccProviderSDK.Meta().(*framework.Clients).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_workspace" {
@@ -1055,6 +1105,49 @@ resource "scalr_workspace" "test" {
 	)
 }
 
+func testAccScalrWorkspaceProviderConfigurationRemoved(rInt int) string {
+	return fmt.Sprintf(testAccScalrWorkspaceCommonConfig, rInt, defaultAccount,
+		fmt.Sprintf(`
+resource "scalr_workspace" "test" {
+  name              = "workspace-pcfg-test"
+  environment_id    = scalr_environment.test.id
+  auto_apply        = false
+  execution_mode    = "%s"
+  working_directory = "terraform/test"
+}`, scalr.WorkspaceExecutionModeLocal),
+	)
+}
+
+func testAccScalrWorkspaceProviderConfigurationUnmanaged(rInt int) string {
+	return fmt.Sprintf(testAccScalrWorkspaceCommonConfig, rInt, defaultAccount,
+		fmt.Sprintf(`
+resource "scalr_provider_configuration" "kubernetes" {
+  name         = "kubernetes"
+  account_id   = scalr_environment.test.account_id
+  environments = ["*"]
+  custom {
+    provider_name = "kubernetes"
+    argument {
+      name  = "config_path"
+      value = "~/.kube/config"
+    }
+  }
+}
+
+resource "scalr_workspace" "test" {
+  name                           = "workspace-pcfg-test"
+  environment_id                 = scalr_environment.test.id
+  auto_apply                     = false
+  execution_mode                 = "%s"
+  working_directory              = "terraform/test"
+  manage_provider_configurations = false
+  provider_configuration {
+    id = scalr_provider_configuration.kubernetes.id
+  }
+}`, scalr.WorkspaceExecutionModeLocal),
+	)
+}
+
 func testAccScalrWorkspaceProviderConfigurationCreateRollback(rInt int, shared bool) string {
 	environments := ""
 	if shared {
@@ -1087,7 +1180,7 @@ resource "scalr_workspace" "test" {
 	)
 }
 
-func testAccScalrWorkspaceWithSSHKeyConfig(rInt int, sshKeyName string) string {
+func testAccScalrWorkspaceWithSSHKeyConfig(rInt int, sshKeyName, sshKey string) string {
 	return fmt.Sprintf(`
 resource "scalr_environment" "test" {
   name       = "test-env-%d"
@@ -1108,11 +1201,11 @@ EOF
 resource "scalr_workspace" "test" {
   name                   = "workspace-with-ssh-key"
   environment_id         = scalr_environment.test.id
-  ssh_key_id             = scalr_ssh_key.test.id
   auto_apply             = true
   execution_mode         = "remote"
   working_directory      = ""
-}`, rInt, defaultAccount, sshKeyName)
+  %s
+}`, rInt, defaultAccount, sshKeyName, sshKey)
 }
 
 func testAccScalrWorkspaceWithStateConsumersConfig(rInt int) string {
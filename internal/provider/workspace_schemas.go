@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -185,6 +186,10 @@ func workspaceResourceSchema(ctx context.Context) *schema.Schema {
 			"ssh_key_id": schema.StringAttribute{
 				MarkdownDescription: "The identifier of the SSH key to use for the workspace.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"tag_ids": schema.SetAttribute{
 				MarkdownDescription: "List of tag IDs associated with the workspace.",
@@ -197,10 +202,11 @@ func workspaceResourceSchema(ctx context.Context) *schema.Schema {
 				},
 			},
 			"remote_state_consumers": schema.SetAttribute{
-				MarkdownDescription: "The list of workspace identifiers that are allowed to access the state of this workspace. Use `[\"*\"]` to share the state with all the workspaces within the environment (default).",
-				ElementType:         types.StringType,
-				Optional:            true,
-				Computed:            true,
+				MarkdownDescription: "The list of workspace identifiers that are allowed to access the state of this workspace. Use `[\"*\"]` to share the state with all the workspaces within the environment (default)." +
+					" If omitted, the consumers of the workspace state are not managed, so they can be added with the `scalr_workspace_remote_state_consumer` resource instead.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.Set{
 					setvalidator.ValueStringsAre(stringvalidation.StringIsNotWhiteSpace()),
 				},
@@ -208,6 +214,19 @@ func workspaceResourceSchema(ctx context.Context) *schema.Schema {
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"remote_state_sharing": schema.BoolAttribute{
+				MarkdownDescription: "Whether the state is shared with all the workspaces within the environment." +
+					" Set it to `false` to restrict the access to the state when `remote_state_consumers` is not managed by this resource." +
+					" This attribute conflicts with `remote_state_consumers`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("remote_state_consumers")),
+				},
+			},
 			"remote_backend": schema.BoolAttribute{
 				MarkdownDescription: "Manages if Scalr exports the remote backend configuration and state storage for your" +
 					" infrastructure management. Disabling this feature will also prevent the ability to perform state locking," +
@@ -220,6 +239,34 @@ func workspaceResourceSchema(ctx context.Context) *schema.Schema {
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"manage_provider_configurations": schema.BoolAttribute{
+				MarkdownDescription: "Whether the provider configuration links of the workspace are managed by this resource." +
+					" If `true` (default), the `provider_configuration` blocks are authoritative, and removing all of them" +
+					" detaches every provider configuration from the workspace. Set it to `false` to leave the links as they are," +
+					" e.g. to create them with the `scalr_workspace_provider_configuration` resource instead;" +
+					" the `provider_configuration` blocks cannot be used then.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"manage_tags": schema.BoolAttribute{
+				MarkdownDescription: "Whether the tags of the workspace are managed by this resource." +
+					" If `true` (default), `tag_ids` is authoritative, and omitting it removes all tags from the workspace." +
+					" Set it to `false` to leave the tags as they are, e.g. to attach them with the `scalr_workspace_tag`" +
+					" resource instead; `tag_ids` cannot be set then.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"manage_ssh_key": schema.BoolAttribute{
+				MarkdownDescription: "Whether the SSH key of the workspace is managed by this resource." +
+					" If `true` (default), omitting `ssh_key_id` unlinks the SSH key from the workspace." +
+					" Set it to `false` to leave the SSH key as it is, e.g. to link it with the `scalr_workspace_ssh_key`" +
+					" resource instead; `ssh_key_id` cannot be set then.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"hooks": schema.ListNestedBlock{
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ resource.Resource                = &workspaceSSHKeyResource{}
+	_ resource.ResourceWithConfigure   = &workspaceSSHKeyResource{}
+	_ resource.ResourceWithImportState = &workspaceSSHKeyResource{}
+)
+
+func newWorkspaceSSHKeyResource() resource.Resource {
+	return &workspaceSSHKeyResource{}
+}
+
+// workspaceSSHKeyResource defines the resource implementation.
+type workspaceSSHKeyResource struct {
+	framework.ResourceWithScalrClient
+}
+
+// workspaceSSHKeyResourceModel describes the resource data model.
+type workspaceSSHKeyResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	SSHKeyID    types.String `tfsdk:"ssh_key_id"`
+}
+
+// workspaceSSHKeyAPIErrorPaths maps the API error sources of the workspace SSH key to its attributes.
+var workspaceSSHKeyAPIErrorPaths = framework.APIErrorPaths{
+	"/data/relationships/ssh-key": path.Root("ssh_key_id"),
+}
+
+func (r *workspaceSSHKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_ssh_key"
+}
+
+func (r *workspaceSSHKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Links an SSH key to a workspace in Scalr, so that the link can be managed independently of the workspace." +
+			" Changing the key relinks the workspace in place, without unlinking it first." +
+			"\n\n~> **Note:** Set `manage_ssh_key = false` on the `scalr_workspace` resource of the linked workspace," +
+			" otherwise it will unlink the SSH key linked with this resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource, equal to the ID of the workspace.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace, in the format `ws-<RANDOM STRING>`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"ssh_key_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the SSH key, in the format `ssh-<RANDOM STRING>`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *workspaceSSHKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
+	var plan workspaceSSHKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.link(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.WorkspaceID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *workspaceSSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workspaceSSHKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ws, err := r.ClientV2.Workspace.GetWorkspace(ctx, state.Id.ValueString(), nil)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving workspace", err.Error())
+		return
+	}
+
+	if ws.Relationships.SshKey == nil {
+		// The key was unlinked outside of Terraform.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.WorkspaceID = types.StringValue(ws.ID)
+	state.SSHKeyID = types.StringValue(ws.Relationships.SshKey.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *workspaceSSHKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
+	var plan workspaceSSHKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Linking another key replaces the current one, so the workspace is never left without a key.
+	resp.Diagnostics.Append(r.link(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *workspaceSSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
+	var state workspaceSSHKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.ClientV2.Misc.DeleteWorkspaceSshKeyLink(ctx, state.WorkspaceID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.Append(framework.APIErrorDiagnostics(
+			"Error deleting SSH key link", err,
+			framework.WithRequiredPermission("workspaces:update"),
+		)...)
+	}
+}
+
+func (r *workspaceSSHKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *workspaceSSHKeyResource) link(ctx context.Context, plan workspaceSSHKeyResourceModel) diag.Diagnostics {
+	_, err := r.ClientV2.Misc.CreateWorkspaceSshKeyLink(
+		ctx,
+		plan.WorkspaceID.ValueString(),
+		&schemas.WorkspaceSSHKeyLinkRequest{SshKey: plan.SSHKeyID.ValueString()},
+	)
+	if err != nil {
+		return framework.APIErrorDiagnostics(
+			"Error creating SSH key link", err,
+			framework.WithRequiredPermission("workspaces:update"),
+			framework.WithAttributePaths(workspaceSSHKeyAPIErrorPaths),
+		)
+	}
+	return nil
+}
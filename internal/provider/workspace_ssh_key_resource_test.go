@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccScalrWorkspaceSSHKey_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrWorkspaceSSHKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWorkspaceSSHKeyConfig(rInt, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrWorkspaceSSHKeyExists("scalr_workspace_ssh_key.test", "scalr_ssh_key.first"),
+					resource.TestCheckResourceAttrPair(
+						"scalr_workspace_ssh_key.test", "id",
+						"scalr_workspace.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"scalr_workspace_ssh_key.test", "ssh_key_id",
+						"scalr_ssh_key.first", "id",
+					),
+				),
+			},
+			{
+				// The workspace doesn't manage its SSH key, so the linked one causes no drift.
+				Config: testAccScalrWorkspaceSSHKeyConfig(rInt, "first"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccScalrWorkspaceSSHKeyConfig(rInt, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("scalr_workspace_ssh_key.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrWorkspaceSSHKeyExists("scalr_workspace_ssh_key.test", "scalr_ssh_key.second"),
+				),
+			},
+			{
+				ResourceName:      "scalr_workspace_ssh_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScalrWorkspaceSSHKeyExists(resID, sshKeyResID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		scalrClient := createScalrClientV2()
+
+		rs, ok := s.RootModule().Resources[resID]
+		if !ok {
+			return fmt.Errorf("not found: %s", resID)
+		}
+		key, ok := s.RootModule().Resources[sshKeyResID]
+		if !ok {
+			return fmt.Errorf("not found: %s", sshKeyResID)
+		}
+
+		ws, err := scalrClient.Workspace.GetWorkspace(ctx, rs.Primary.ID, nil)
+		if err != nil {
+			return fmt.Errorf("error reading workspace %s: %w", rs.Primary.ID, err)
+		}
+		if ws.Relationships.SshKey == nil {
+			return fmt.Errorf("no SSH key linked to workspace %s", rs.Primary.ID)
+		}
+		if ws.Relationships.SshKey.ID != key.Primary.ID {
+			return fmt.Errorf("expected SSH key %s linked to workspace %s, got %s", key.Primary.ID, rs.Primary.ID, ws.Relationships.SshKey.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckScalrWorkspaceSSHKeyDestroy(s *terraform.State) error {
+	scalrClient := createScalrClientV2()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "scalr_workspace_ssh_key" {
+			continue
+		}
+
+		ws, err := scalrClient.Workspace.GetWorkspace(ctx, rs.Primary.ID, nil)
+		if err != nil {
+			// Workspace may already be deleted — treat as success.
+			continue
+		}
+		if ws.Relationships.SshKey != nil {
+			return fmt.Errorf("SSH key %s still linked to workspace %s", ws.Relationships.SshKey.ID, ws.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccScalrWorkspaceSSHKeyConfig(rInt int, linked string) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name       = "test-env-%[1]d"
+  account_id = "%[2]s"
+}
+
+resource "scalr_ssh_key" "first" {
+  name         = "workspace-ssh-key-first-%[1]d"
+  private_key  = %[3]q
+  account_id   = "%[2]s"
+  environments = [scalr_environment.test.id]
+}
+
+resource "scalr_ssh_key" "second" {
+  name         = "workspace-ssh-key-second-%[1]d"
+  private_key  = %[4]q
+  account_id   = "%[2]s"
+  environments = [scalr_environment.test.id]
+}
+
+resource "scalr_workspace" "test" {
+  name           = "workspace-ssh-key-%[1]d"
+  environment_id = scalr_environment.test.id
+  manage_ssh_key = false
+}
+
+resource "scalr_workspace_ssh_key" "test" {
+  workspace_id = scalr_workspace.test.id
+  ssh_key_id   = scalr_ssh_key.%[5]s.id
+}`, rInt, defaultAccount, testSSHKeyPKCS8, testSSHKeyOpenSSH, linked)
+}
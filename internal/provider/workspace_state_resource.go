@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+	"github.com/scalr/go-scalr/v2/scalr/value"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+	"github.com/scalr/terraform-provider-scalr/internal/framework/validation/stringvalidation"
+)
+
+// Compile-time interface checks
+var (
+	_ resource.Resource               = &workspaceStateResource{}
+	_ resource.ResourceWithConfigure  = &workspaceStateResource{}
+	_ resource.ResourceWithModifyPlan = &workspaceStateResource{}
+)
+
+func newWorkspaceStateResource() resource.Resource {
+	return &workspaceStateResource{}
+}
+
+// workspaceStateResource defines the resource implementation.
+type workspaceStateResource struct {
+	framework.ResourceWithScalrClient
+}
+
+// workspaceStateResourceModel describes the resource data model.
+type workspaceStateResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	WorkspaceID    types.String `tfsdk:"workspace_id"`
+	State          types.String `tfsdk:"state"`
+	Force          types.Bool   `tfsdk:"force"`
+	StateVersionID types.String `tfsdk:"state_version_id"`
+	Serial         types.Int64  `tfsdk:"serial"`
+	Lineage        types.String `tfsdk:"lineage"`
+	Md5            types.String `tfsdk:"md5"`
+}
+
+// stateFile holds the attributes of a Terraform state file the upload is validated against.
+type stateFile struct {
+	serial  int64
+	lineage string
+	md5     string
+}
+
+// workspaceStateAPIErrorPaths maps the API error sources of the workspace state to its attributes.
+var workspaceStateAPIErrorPaths = framework.APIErrorPaths{
+	"/data/attributes/state":   path.Root("state"),
+	"/data/attributes/serial":  path.Root("serial"),
+	"/data/attributes/lineage": path.Root("lineage"),
+	"/data/attributes/force":   path.Root("force"),
+}
+
+func (r *workspaceStateResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_state"
+}
+
+func (r *workspaceStateResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads a Terraform state file into a workspace, e.g. when migrating existing" +
+			" infrastructure to Scalr. The state is only uploaded into a workspace without resources in its" +
+			" current state, unless `force` is set." +
+			"\n\n~> **Note:** State versions cannot be deleted. Destroying this resource only removes it" +
+			" from the Terraform state, the uploaded state version remains in the workspace." +
+			" Runs of the workspace create new state versions that are not tracked by this resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace to upload the state into, in the format `ws-<RANDOM STRING>`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The content of the `terraform.tfstate` file, e.g. `file(\"terraform.tfstate\")`." +
+					" Must be a state in format version 4 with the `lineage` and `serial` set." +
+					" A change of the content uploads a new state version.",
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidation.StringIsNotWhiteSpace(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Upload the state even if the current state of the workspace has resources," +
+					" or has a different lineage or a newer serial. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"state_version_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the uploaded state version.",
+				Computed:            true,
+			},
+			"serial": schema.Int64Attribute{
+				MarkdownDescription: "The serial of the uploaded state.",
+				Computed:            true,
+			},
+			"lineage": schema.StringAttribute{
+				MarkdownDescription: "The lineage of the uploaded state.",
+				Computed:            true,
+			},
+			"md5": schema.StringAttribute{
+				MarkdownDescription: "The MD5 hash of the uploaded state.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *workspaceStateResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		// Nothing to validate on destroy.
+		return
+	}
+
+	var plan workspaceStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.State.IsUnknown() || plan.State.IsNull() {
+		return
+	}
+
+	sf, err := parseStateFile(plan.State.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("state"), "Invalid state file", err.Error())
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state workspaceStateResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.Md5.ValueString() == sf.md5 {
+			// The content has not changed, nothing to upload.
+			plan.StateVersionID = state.StateVersionID
+		}
+	}
+
+	plan.Serial = types.Int64Value(sf.serial)
+	plan.Lineage = types.StringValue(sf.lineage)
+	plan.Md5 = types.StringValue(sf.md5)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *workspaceStateResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan workspaceStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.upload(ctx, &plan, "", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.WorkspaceID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *workspaceStateResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state workspaceStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.ClientV2.StateVersion.GetStateVersion(ctx, state.StateVersionID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Debug(ctx, "State version not found, removing from state", map[string]interface{}{
+				"state_version_id": state.StateVersionID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading state version %s", state.StateVersionID.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	// The uploaded content is not read back: runs of the workspace create
+	// new state versions, which must not be reported as drift of this resource.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *workspaceStateResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state workspaceStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Md5.ValueString() != state.Md5.ValueString() {
+		r.upload(ctx, &plan, state.StateVersionID.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.StateVersionID = state.StateVersionID
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *workspaceStateResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+	// State versions cannot be deleted, the resource is only removed from the Terraform state.
+}
+
+// upload validates the state against the current state of the workspace and uploads it as a new state version.
+// The previous state version uploaded by this resource, if any, may be succeeded without `force`
+// by a state of the same lineage and a greater serial, as long as no run has changed the state since.
+func (r *workspaceStateResource) upload(
+	ctx context.Context,
+	plan *workspaceStateResourceModel,
+	previousID string,
+	diags *diag.Diagnostics,
+) {
+	workspaceID := plan.WorkspaceID.ValueString()
+	content := plan.State.ValueString()
+
+	sf, err := parseStateFile(content)
+	if err != nil {
+		diags.AddAttributeError(path.Root("state"), "Invalid state file", err.Error())
+		return
+	}
+
+	_, err = r.ClientV2.Workspace.LockWorkspace(ctx, workspaceID, &schemas.Reason{Reason: "Uploading state via Terraform"})
+	if err != nil {
+		diags.Append(framework.APIErrorDiagnostics(
+			fmt.Sprintf("Error locking workspace %s", workspaceID), err,
+			framework.WithRequiredPermission("workspaces:lock"),
+		)...)
+		return
+	}
+	defer func() {
+		if _, err := r.ClientV2.Workspace.UnlockWorkspace(ctx, workspaceID); err != nil {
+			diags.AddWarning(
+				"Error unlocking workspace",
+				fmt.Sprintf("The state was processed, but workspace %s could not be unlocked: %v", workspaceID, err),
+			)
+		}
+	}()
+
+	current, err := r.ClientV2.StateVersion.GetCurrentStateVersion(ctx, workspaceID)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		diags.AddError(
+			fmt.Sprintf("Error reading current state of workspace %s", workspaceID),
+			err.Error(),
+		)
+		return
+	}
+	if err == nil && !plan.Force.ValueBool() {
+		if msg := checkStateSuccession(current, previousID, sf); msg != "" {
+			diags.AddAttributeError(
+				path.Root("state"),
+				"Workspace already has state",
+				msg+" Set `force = true` to overwrite the current state.",
+			)
+			return
+		}
+	}
+
+	opts := &schemas.StateVersionRequest{
+		Attributes: schemas.StateVersionAttributesRequest{
+			Force:   value.Set(plan.Force.ValueBool()),
+			Lineage: value.Set(sf.lineage),
+			Md5:     value.Set(sf.md5),
+			Serial:  value.Set(int(sf.serial)),
+			State:   value.Set(base64.StdEncoding.EncodeToString([]byte(content))),
+		},
+		Relationships: schemas.StateVersionRelationshipsRequest{
+			Workspace: value.Set(schemas.Workspace{ID: workspaceID}),
+		},
+	}
+
+	sv, err := r.ClientV2.StateVersion.CreateStateVersion(ctx, opts)
+	if err != nil {
+		diags.Append(framework.APIErrorDiagnostics(
+			"Error uploading state", err,
+			framework.WithAttributePaths(workspaceStateAPIErrorPaths),
+			framework.WithRequiredPermission("state-versions:create"),
+		)...)
+		return
+	}
+
+	if sv.Attributes.Md5 != "" && sv.Attributes.Md5 != sf.md5 {
+		diags.AddError(
+			"State checksum mismatch",
+			fmt.Sprintf(
+				"The MD5 hash of state version %s is %s, expected %s. The state may have been corrupted during the upload.",
+				sv.ID, sv.Attributes.Md5, sf.md5,
+			),
+		)
+		return
+	}
+
+	plan.StateVersionID = types.StringValue(sv.ID)
+	plan.Serial = types.Int64Value(sf.serial)
+	plan.Lineage = types.StringValue(sf.lineage)
+	plan.Md5 = types.StringValue(sf.md5)
+}
+
+// checkStateSuccession returns the reason why the current state of the workspace must not be overwritten
+// by the given state without `force`, or an empty string if it can be.
+func checkStateSuccession(current *schemas.StateVersion, previousID string, sf *stateFile) string {
+	if len(current.Attributes.Resources) == 0 {
+		return ""
+	}
+
+	if previousID == "" || current.ID != previousID {
+		return fmt.Sprintf("The current state version %s has %d resources.", current.ID, len(current.Attributes.Resources))
+	}
+
+	if current.Attributes.Lineage != nil && *current.Attributes.Lineage != sf.lineage {
+		return fmt.Sprintf(
+			"The lineage %q of the state does not match the lineage %q of the current state.",
+			sf.lineage, *current.Attributes.Lineage,
+		)
+	}
+
+	if sf.serial <= int64(current.Attributes.Serial) {
+		return fmt.Sprintf(
+			"The serial %d of the state must be greater than the serial %d of the current state.",
+			sf.serial, current.Attributes.Serial,
+		)
+	}
+
+	return ""
+}
+
+// parseStateFile validates the content of a Terraform state file and returns its attributes.
+func parseStateFile(content string) (*stateFile, error) {
+	var raw struct {
+		Version *int    `json:"version"`
+		Serial  *int64  `json:"serial"`
+		Lineage *string `json:"lineage"`
+	}
+	if err := json.Unmarshal([]byte(content), &raw); err != nil {
+		return nil, fmt.Errorf("the state is not valid JSON: %w", err)
+	}
+
+	switch {
+	case raw.Version == nil:
+		return nil, errors.New("the state has no `version`")
+	case *raw.Version != 4:
+		return nil, fmt.Errorf("the state format version %d is not supported, only version 4 is", *raw.Version)
+	case raw.Lineage == nil || *raw.Lineage == "":
+		return nil, errors.New("the state has no `lineage`")
+	case raw.Serial == nil:
+		return nil, errors.New("the state has no `serial`")
+	case *raw.Serial < 0:
+		return nil, fmt.Errorf("the state has a negative `serial` %d", *raw.Serial)
+	}
+
+	sum := md5.Sum([]byte(content))
+	return &stateFile{
+		serial:  *raw.Serial,
+		lineage: *raw.Lineage,
+		md5:     hex.EncodeToString(sum[:]),
+	}, nil
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+)
+
+func TestParseStateFile(t *testing.T) {
+	sf, err := parseStateFile(`{"version": 4, "serial": 3, "lineage": "abc", "resources": []}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sf.serial != 3 || sf.lineage != "abc" || len(sf.md5) != 32 {
+		t.Errorf("unexpected state file attributes: %+v", sf)
+	}
+
+	for content, want := range map[string]string{
+		`not json`:                                       "not valid JSON",
+		`{"serial": 1, "lineage": "abc"}`:                "no `version`",
+		`{"version": 3, "serial": 1}`:                    "version 3 is not supported",
+		`{"version": 4, "serial": 1}`:                    "no `lineage`",
+		`{"version": 4, "lineage": "abc"}`:               "no `serial`",
+		`{"version": 4, "serial": -1, "lineage": "abc"}`: "negative `serial`",
+	} {
+		if _, err := parseStateFile(content); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error containing %q for %s, got: %v", want, content, err)
+		}
+	}
+}
+
+func TestCheckStateSuccession(t *testing.T) {
+	lineage := "abc"
+	current := &schemas.StateVersion{
+		ID: "sv-1",
+		Attributes: schemas.StateVersionAttributes{
+			Lineage:   &lineage,
+			Serial:    2,
+			Resources: []map[string]interface{}{{"type": "null_resource"}},
+		},
+	}
+	empty := &schemas.StateVersion{ID: "sv-1", Attributes: schemas.StateVersionAttributes{Lineage: &lineage, Serial: 2}}
+
+	tests := map[string]struct {
+		current    *schemas.StateVersion
+		previousID string
+		sf         *stateFile
+		allowed    bool
+	}{
+		"empty state":           {empty, "", &stateFile{serial: 1, lineage: "other"}, true},
+		"non-empty state":       {current, "", &stateFile{serial: 3, lineage: "abc"}, false},
+		"changed by a run":      {current, "sv-0", &stateFile{serial: 3, lineage: "abc"}, false},
+		"successor":             {current, "sv-1", &stateFile{serial: 3, lineage: "abc"}, true},
+		"lineage mismatch":      {current, "sv-1", &stateFile{serial: 3, lineage: "other"}, false},
+		"serial not increasing": {current, "sv-1", &stateFile{serial: 2, lineage: "abc"}, false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := checkStateSuccession(tt.current, tt.previousID, tt.sf)
+			if allowed := msg == ""; allowed != tt.allowed {
+				t.Errorf("expected allowed=%t, got reason: %q", tt.allowed, msg)
+			}
+		})
+	}
+}
+
+func TestAccScalrWorkspaceState_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScalrWorkspaceStateConfig(rInt, `{"version": 4, "serial": 1}`, false),
+				ExpectError: regexp.MustCompile("the state has no `lineage`"),
+				PlanOnly:    true,
+			},
+			{
+				Config: testAccScalrWorkspaceStateConfig(rInt, testAccScalrWorkspaceStateContent(1, "hello"), false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("scalr_workspace_state.test", "id", "scalr_workspace.test", "id"),
+					resource.TestCheckResourceAttrSet("scalr_workspace_state.test", "state_version_id"),
+					resource.TestCheckResourceAttrSet("scalr_workspace_state.test", "md5"),
+					resource.TestCheckResourceAttr("scalr_workspace_state.test", "serial", "1"),
+					resource.TestCheckResourceAttr("scalr_workspace_state.test", "lineage", "test-lineage"),
+					resource.TestCheckResourceAttr("scalr_workspace_state.test", "force", "false"),
+				),
+			},
+			{
+				// A newer serial of the same lineage succeeds the uploaded state.
+				Config: testAccScalrWorkspaceStateConfig(rInt, testAccScalrWorkspaceStateContent(2, "world"), false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_workspace_state.test", "serial", "2"),
+				),
+			},
+			{
+				// The uploaded state has resources, so going back requires force.
+				Config:      testAccScalrWorkspaceStateConfig(rInt, testAccScalrWorkspaceStateContent(1, "hello"), false),
+				ExpectError: regexp.MustCompile("Workspace already has state"),
+			},
+			{
+				Config: testAccScalrWorkspaceStateConfig(rInt, testAccScalrWorkspaceStateContent(1, "hello"), true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_workspace_state.test", "serial", "1"),
+					resource.TestCheckResourceAttr("scalr_workspace_state.test", "force", "true"),
+				),
+			},
+		},
+	})
+}
+
+// testAccScalrWorkspaceStateContent returns a state file with a single resource and output.
+func testAccScalrWorkspaceStateContent(serial int, greeting string) string {
+	return fmt.Sprintf(`{
+  "version": 4,
+  "terraform_version": "1.5.7",
+  "serial": %[1]d,
+  "lineage": "test-lineage",
+  "outputs": {
+    "greeting": {"value": %[2]q, "type": "string"}
+  },
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "terraform_data",
+      "name": "greeting",
+      "provider": "provider[\"terraform.io/builtin/terraform\"]",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {"id": "greeting", "input": %[2]q, "output": %[2]q, "triggers_replace": null}
+        }
+      ]
+    }
+  ],
+  "check_results": null
+}`, serial, greeting)
+}
+
+func testAccScalrWorkspaceStateConfig(rInt int, content string, force bool) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name       = "test-env-%[1]d"
+  account_id = "%[2]s"
+}
+
+resource "scalr_workspace" "test" {
+  name           = "workspace-state-test-%[1]d"
+  environment_id = scalr_environment.test.id
+}
+
+resource "scalr_workspace_state" "test" {
+  workspace_id = scalr_workspace.test.id
+  state        = <<-EOT
+%[3]s
+EOT
+  force        = %[4]t
+}`, rInt, defaultAccount, content, force)
+}
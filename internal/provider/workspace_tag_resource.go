@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	scalrV2 "github.com/scalr/go-scalr/v2/scalr"
+	"github.com/scalr/go-scalr/v2/scalr/schemas"
+
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
+)
+
+func newWorkspaceTagResource() resource.Resource {
+	return &tagLinkResource{
+		cfg: tagLinkConfig{
+			typeName:          "workspace_tag",
+			object:            "workspace",
+			objectAttribute:   "workspace_id",
+			objectDescription: "ID of the workspace, in the format `ws-<RANDOM STRING>`.",
+			description: "Attaches a tag to a workspace in Scalr. Unlike `scalr_workspace.tag_ids`, this resource" +
+				" is non-authoritative: it manages a single tag and leaves the other tags of the workspace untouched." +
+				"\n\n~> **Note:** Set `manage_tags = false` on the `scalr_workspace` resource of the tagged workspace," +
+				" otherwise it will remove the tags attached with this resource.",
+			lookupCache: framework.LookupWorkspaces,
+			permission:  "workspaces:update",
+			addTags: func(ctx context.Context, c *scalrV2.Client, id string, tags []schemas.Tag) error {
+				return c.Workspace.AddWorkspaceTags(ctx, id, tags)
+			},
+			listTags: func(ctx context.Context, c *scalrV2.Client, id string) iter.Seq2[schemas.Tag, error] {
+				return c.Workspace.ListWorkspaceTagsIter(ctx, id, nil)
+			},
+			deleteTags: func(ctx context.Context, c *scalrV2.Client, id string, tags []schemas.Tag) error {
+				return c.Workspace.DeleteWorkspaceTags(ctx, id, tags)
+			},
+		},
+	}
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccScalrWorkspaceTag_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrWorkspaceTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWorkspaceTagConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrWorkspaceTagExists("scalr_workspace_tag.foo"),
+					testAccCheckScalrWorkspaceTagExists("scalr_workspace_tag.bar"),
+					resource.TestCheckResourceAttrPair(
+						"scalr_workspace_tag.foo", "workspace_id",
+						"scalr_workspace.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"scalr_workspace_tag.foo", "tag_id",
+						"scalr_tag.foo", "id",
+					),
+				),
+			},
+			{
+				// The workspace doesn't manage its tags, so the attached ones cause no drift.
+				Config: testAccScalrWorkspaceTagConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_workspace.test", "manage_tags", "false"),
+					resource.TestCheckResourceAttr("scalr_workspace.test", "tag_ids.#", "0"),
+				),
+			},
+			{
+				Config:   testAccScalrWorkspaceTagConfig(rInt),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccScalrWorkspaceTagConfig(rInt) + testAccScalrWorkspaceTagUnmanagedConfig(rInt),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Tags are not managed"),
+			},
+		},
+	})
+}
+
+func TestAccScalrWorkspaceTag_import(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: protoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckScalrWorkspaceTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWorkspaceTagConfig(rInt),
+			},
+			{
+				ResourceName:      "scalr_workspace_tag.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScalrWorkspaceTagExists(resID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		scalrClient := createScalrClientV2()
+
+		rs, ok := s.RootModule().Resources[resID]
+		if !ok {
+			return fmt.Errorf("not found: %s", resID)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no instance ID is set")
+		}
+
+		parts := strings.SplitN(rs.Primary.ID, "/", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid ID format: %s", rs.Primary.ID)
+		}
+		workspaceID, tagID := parts[0], parts[1]
+
+		tags, err := scalrClient.Workspace.ListWorkspaceTags(ctx, workspaceID, nil)
+		if err != nil {
+			return fmt.Errorf("error listing tags for workspace %s: %w", workspaceID, err)
+		}
+
+		for _, tag := range tags {
+			if tag.ID == tagID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("tag %s not attached to workspace %s", tagID, workspaceID)
+	}
+}
+
+func testAccCheckScalrWorkspaceTagDestroy(s *terraform.State) error {
+	scalrClient := createScalrClientV2()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "scalr_workspace_tag" {
+			continue
+		}
+		if rs.Primary.ID == "" {
+			continue
+		}
+
+		parts := strings.SplitN(rs.Primary.ID, "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		workspaceID, tagID := parts[0], parts[1]
+
+		tags, err := scalrClient.Workspace.ListWorkspaceTags(ctx, workspaceID, nil)
+		if err != nil {
+			// Workspace may already be deleted — treat as success.
+			continue
+		}
+
+		for _, tag := range tags {
+			if tag.ID == tagID {
+				return fmt.Errorf("tag %s still attached to workspace %s", tagID, workspaceID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccScalrWorkspaceTagConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name       = "test-env-%d"
+  account_id = "%s"
+}
+
+resource "scalr_workspace" "test" {
+  name           = "test-ws-%[1]d"
+  environment_id = scalr_environment.test.id
+  manage_tags    = false
+}
+
+resource "scalr_tag" "foo" {
+  name = "test-tag-foo-%[1]d"
+}
+
+resource "scalr_tag" "bar" {
+  name = "test-tag-bar-%[1]d"
+}
+
+resource "scalr_workspace_tag" "foo" {
+  workspace_id = scalr_workspace.test.id
+  tag_id       = scalr_tag.foo.id
+}
+
+resource "scalr_workspace_tag" "bar" {
+  workspace_id = scalr_workspace.test.id
+  tag_id       = scalr_tag.bar.id
+}
+`, rInt, defaultAccount)
+}
+
+// testAccScalrWorkspaceTagUnmanagedConfig adds a workspace that sets tag_ids without managing its tags.
+func testAccScalrWorkspaceTagUnmanagedConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "scalr_workspace" "unmanaged" {
+  name           = "test-ws-unmanaged-%d"
+  environment_id = scalr_environment.test.id
+  manage_tags    = false
+  tag_ids        = [scalr_tag.foo.id]
+}
+`, rInt)
+}
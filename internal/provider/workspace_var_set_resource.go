@@ -91,6 +91,8 @@ func (r *workspaceVarSetResource) Create(
 	req resource.CreateRequest,
 	resp *resource.CreateResponse,
 ) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
 	var plan workspaceVarSetResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -151,6 +153,8 @@ func (r *workspaceVarSetResource) Delete(
 	req resource.DeleteRequest,
 	resp *resource.DeleteResponse,
 ) {
+	defer r.LookupCache.Invalidate(framework.LookupWorkspaces)
+
 	var state workspaceVarSetResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/scalr/terraform-provider-scalr/internal/framework"
 	"github.com/scalr/terraform-provider-scalr/internal/provider"
 	"github.com/scalr/terraform-provider-scalr/version"
 )
@@ -41,11 +42,15 @@ func main() {
 
 	schema.DescriptionKind = schema.StringMarkdown
 
+	// Name-based lookups are cached for the lifetime of this provider instance.
+	// Both providers share the cache, so a mutation made by one invalidates the lookups made by the other.
+	lookupCache := framework.NewLookupCache(framework.DefaultLookupCacheTTL)
+
 	providers := []func() tfprotov5.ProviderServer{
 		// New provider implementation with Terraform Plugin Framework
-		providerserver.NewProtocol5(provider.New(version.ProviderVersion)()),
+		providerserver.NewProtocol5(provider.New(version.ProviderVersion, lookupCache)()),
 		// Classic provider implementation with Terraform Plugin SDK
-		provider.Provider(version.ProviderVersion).GRPCProvider,
+		provider.Provider(version.ProviderVersion, lookupCache).GRPCProvider,
 	}
 
 	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)